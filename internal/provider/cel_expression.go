@@ -0,0 +1,237 @@
+// cel_expression.go - Custom string type for CEL expressions evaluated by Keep
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ basetypes.StringTypable                    = celExpressionType{}
+	_ basetypes.StringValuableWithSemanticEquals = celExpression{}
+	_ xattr.ValidateableAttribute                = celExpression{}
+)
+
+// celAlertFields are the alert fields Keep makes available to CEL expressions.
+// Alerts may carry additional fields sent by their provider, so references to
+// other names are reported as warnings rather than errors.
+var celAlertFields = []string{
+	"id",
+	"name",
+	"status",
+	"severity",
+	"lastReceived",
+	"firingStartTime",
+	"firingCounter",
+	"environment",
+	"service",
+	"source",
+	"message",
+	"description",
+	"url",
+	"imageUrl",
+	"labels",
+	"fingerprint",
+	"deleted",
+	"dismissed",
+	"dismissUntil",
+	"assignee",
+	"providerId",
+	"providerType",
+	"note",
+	"startedAt",
+	"isNoisy",
+	"enriched_fields",
+	"incident",
+	"event_id",
+	"pushed",
+	"apiKeyRef",
+	"alert_hash",
+	"ticket_url",
+}
+
+// celMatchAll are the condition values Keep treats as "always apply" without
+// evaluating them as CEL.
+var celMatchAll = map[string]bool{"": true, "*": true}
+
+var (
+	celEnvOnce sync.Once
+	celEnv     *cel.Env
+	celEnvErr  error
+)
+
+// celEnvironment returns the shared CEL environment declaring the alert fields.
+func celEnvironment() (*cel.Env, error) {
+	celEnvOnce.Do(func() {
+		opts := make([]cel.EnvOption, 0, len(celAlertFields))
+		for _, field := range celAlertFields {
+			opts = append(opts, cel.Variable(field, cel.DynType))
+		}
+		celEnv, celEnvErr = cel.NewEnv(opts...)
+	})
+	return celEnv, celEnvErr
+}
+
+// celExpressionType is a string type holding a CEL expression.
+type celExpressionType struct {
+	basetypes.StringType
+}
+
+// Equal returns true if the given type is equivalent.
+func (t celExpressionType) Equal(o attr.Type) bool {
+	other, ok := o.(celExpressionType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+// String returns a human readable string of the type name.
+func (t celExpressionType) String() string {
+	return "celExpressionType"
+}
+
+// ValueFromString returns a StringValuable type given a StringValue.
+func (t celExpressionType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return celExpression{StringValue: in}, nil
+}
+
+// ValueFromTerraform returns a Value given a tftypes.Value.
+func (t celExpressionType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return celExpression{StringValue: stringValue}, nil
+}
+
+// ValueType returns the Value type.
+func (t celExpressionType) ValueType(_ context.Context) attr.Value {
+	return celExpression{}
+}
+
+// celExpression is a CEL expression value. Expressions that differ only in
+// whitespace are semantically equal.
+type celExpression struct {
+	basetypes.StringValue
+}
+
+// newCELExpressionValue returns a known CEL expression value.
+func newCELExpressionValue(value string) celExpression {
+	return celExpression{StringValue: basetypes.NewStringValue(value)}
+}
+
+// Type returns the attribute type of the value.
+func (v celExpression) Type(_ context.Context) attr.Type {
+	return celExpressionType{}
+}
+
+// Equal returns true if the given value is equivalent.
+func (v celExpression) Equal(o attr.Value) bool {
+	other, ok := o.(celExpression)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both expressions have the same syntax
+// tree, which ignores whitespace and line breaks outside of string literals.
+func (v celExpression) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(celExpression)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	oldExpr := strings.TrimSpace(v.ValueString())
+	newExpr := strings.TrimSpace(newValue.ValueString())
+	if oldExpr == newExpr {
+		return true, diags
+	}
+
+	oldCanonical, ok := canonicalCELExpression(oldExpr)
+	if !ok {
+		return false, diags
+	}
+	newCanonical, ok := canonicalCELExpression(newExpr)
+	if !ok {
+		return false, diags
+	}
+
+	return oldCanonical == newCanonical, diags
+}
+
+// canonicalCELExpression returns the expression formatted from its syntax tree.
+func canonicalCELExpression(expr string) (string, bool) {
+	env, err := celEnvironment()
+	if err != nil {
+		return "", false
+	}
+	ast, iss := env.Parse(expr)
+	if iss.Err() != nil {
+		return "", false
+	}
+	canonical, err := cel.AstToString(ast)
+	if err != nil {
+		return "", false
+	}
+	return canonical, true
+}
+
+// ValidateAttribute parses and type checks the expression, reporting errors
+// with their line and column in the expression.
+func (v celExpression) ValidateAttribute(_ context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() || celMatchAll[strings.TrimSpace(v.ValueString())] {
+		return
+	}
+
+	env, err := celEnvironment()
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Unable to Create CEL Environment",
+			fmt.Sprintf("Could not create the CEL environment used to validate expressions. Please report this issue to the provider developers.\n\n%s", err),
+		)
+		return
+	}
+
+	source := common.NewStringSource(v.ValueString(), req.Path.String())
+	ast, iss := env.ParseSource(source)
+	if iss.Err() != nil {
+		for _, e := range iss.Errors() {
+			resp.Diagnostics.AddAttributeError(req.Path, "Invalid CEL Expression", e.ToDisplayString(source))
+		}
+		return
+	}
+
+	// Type checking mostly flags references to undeclared fields, which may
+	// still exist on alerts from some providers
+	if _, iss := env.Check(ast); iss.Err() != nil {
+		for _, e := range iss.Errors() {
+			resp.Diagnostics.AddAttributeWarning(req.Path, "Possible Problem in CEL Expression", e.ToDisplayString(source))
+		}
+	}
+}
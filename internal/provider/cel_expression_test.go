@@ -0,0 +1,93 @@
+// cel_expression_test.go - Unit tests for the CEL expression custom type
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestCELExpressionValidateAttribute(t *testing.T) {
+	tests := map[string]struct {
+		expr         string
+		wantSeverity diag.Severity
+		wantDetail   string
+	}{
+		"valid": {
+			expr: `source == "prometheus" && labels.team.startsWith("db")`,
+		},
+		"match all": {
+			expr: "*",
+		},
+		"syntax error": {
+			expr:         "severity ==",
+			wantSeverity: diag.SeverityError,
+			wantDetail:   "condition:1:12",
+		},
+		"syntax error on second line": {
+			expr:         "severity == \"critical\" &&\n  name.matches(",
+			wantSeverity: diag.SeverityError,
+			wantDetail:   "condition:2:",
+		},
+		"unknown field": {
+			expr:         `serverity == "critical"`,
+			wantSeverity: diag.SeverityWarning,
+			wantDetail:   "undeclared reference to 'serverity'",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := &xattr.ValidateAttributeResponse{}
+			newCELExpressionValue(tt.expr).ValidateAttribute(context.Background(), xattr.ValidateAttributeRequest{
+				Path: path.Root("condition"),
+			}, resp)
+
+			if tt.wantDetail == "" {
+				if len(resp.Diagnostics) != 0 {
+					t.Fatalf("expected no diagnostics, got: %v", resp.Diagnostics)
+				}
+				return
+			}
+
+			if len(resp.Diagnostics) == 0 {
+				t.Fatal("expected a diagnostic")
+			}
+			d := resp.Diagnostics[0]
+			if d.Severity() != tt.wantSeverity {
+				t.Errorf("expected severity %s, got %s", tt.wantSeverity, d.Severity())
+			}
+			if !strings.Contains(d.Detail(), tt.wantDetail) {
+				t.Errorf("expected detail to contain %q, got: %s", tt.wantDetail, d.Detail())
+			}
+		})
+	}
+}
+
+func TestCELExpressionSemanticEquals(t *testing.T) {
+	tests := []struct {
+		old, new string
+		want     bool
+	}{
+		{`severity == "critical"`, `severity=="critical"`, true},
+		{"severity == \"critical\" &&\n  source == \"grafana\"", `severity == "critical" && source == "grafana"`, true},
+		{`severity == "critical" `, `severity == "critical"`, true},
+		{`name == "a b"`, `name == "ab"`, false},
+		{`severity == "critical"`, `severity == "warning"`, false},
+		{"severity ==", "severity  ==", false},
+	}
+
+	for _, tt := range tests {
+		equal, diags := newCELExpressionValue(tt.old).StringSemanticEquals(context.Background(), newCELExpressionValue(tt.new))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if equal != tt.want {
+			t.Errorf("StringSemanticEquals(%q, %q) = %t, want %t", tt.old, tt.new, equal, tt.want)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+// data_source_extraction_rule.go - Data source implementation for a single KeepHQ extraction rule
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &extractionRuleDataSource{}
+	_ datasource.DataSourceWithConfigure        = &extractionRuleDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &extractionRuleDataSource{}
+)
+
+// NewExtractionRuleDataSource is a helper function to simplify the provider implementation.
+func NewExtractionRuleDataSource() datasource.DataSource {
+	return &extractionRuleDataSource{}
+}
+
+// extractionRuleDataSource defines the data source implementation.
+type extractionRuleDataSource struct {
+	client *keep.Client
+}
+
+// extractionRuleDataModel maps an extraction rule returned by the API. It is
+// the data source model and the element type of keep_extraction_rules.
+type extractionRuleDataModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Priority    types.Int64  `tfsdk:"priority"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+	Pre         types.Bool   `tfsdk:"pre"`
+	Condition   types.String `tfsdk:"condition"`
+	Attribute   types.String `tfsdk:"attribute"`
+	Regex       types.String `tfsdk:"regex"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	CreatedBy   types.String `tfsdk:"created_by"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// newExtractionRuleDataModel converts an extraction rule from the API to the data source model.
+func newExtractionRuleDataModel(rule keep.ExtractionRule) extractionRuleDataModel {
+	return extractionRuleDataModel{
+		ID:          optionalString(string(rule.ID)),
+		Name:        optionalString(rule.Name),
+		Description: optionalStringPointer(rule.Description),
+		Priority:    types.Int64Value(int64(rule.Priority)),
+		Disabled:    types.BoolValue(rule.Disabled),
+		Pre:         types.BoolValue(rule.Pre),
+		Condition:   optionalStringPointer(rule.Condition),
+		Attribute:   optionalStringPointer(rule.Attribute),
+		Regex:       optionalString(rule.Regex),
+		CreatedAt:   optionalString(rule.CreatedAt),
+		CreatedBy:   optionalString(rule.CreatedBy),
+		UpdatedAt:   optionalStringPointer(rule.UpdatedAt),
+	}
+}
+
+// optionalString converts an API string, returning null for empty values.
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// optionalStringPointer converts a nullable API string, returning null for
+// missing or empty values.
+func optionalStringPointer(s *string) types.String {
+	if s == nil {
+		return types.StringNull()
+	}
+	return optionalString(*s)
+}
+
+// extractionRuleDataAttributes returns the computed attributes describing an extraction rule.
+func extractionRuleDataAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The unique identifier of the extraction rule.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the extraction rule.",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "A description of what the extraction rule does.",
+			Computed:    true,
+		},
+		"priority": schema.Int64Attribute{
+			Description: "The priority of the extraction rule (lower number = higher priority).",
+			Computed:    true,
+		},
+		"disabled": schema.BoolAttribute{
+			Description: "Whether the extraction rule is disabled.",
+			Computed:    true,
+		},
+		"pre": schema.BoolAttribute{
+			Description: "Whether this is a pre-processing rule that runs before other rules.",
+			Computed:    true,
+		},
+		"condition": schema.StringAttribute{
+			Description: "CEL expression that determines when this rule is applied.",
+			Computed:    true,
+		},
+		"attribute": schema.StringAttribute{
+			Description: "The attribute extracted from the alert.",
+			Computed:    true,
+		},
+		"regex": schema.StringAttribute{
+			Description: "The regex pattern used for extraction.",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "When the extraction rule was created.",
+			Computed:    true,
+		},
+		"created_by": schema.StringAttribute{
+			Description: "Who created the extraction rule.",
+			Computed:    true,
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "When the extraction rule was last updated.",
+			Computed:    true,
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *extractionRuleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extraction_rule"
+}
+
+// Schema defines the schema for the data source.
+func (d *extractionRuleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := extractionRuleDataAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "The unique identifier of the extraction rule. Exactly one of id or name must be set.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "The name of the extraction rule. Exactly one of id or name must be set; the name must match a single rule.",
+		Optional:    true,
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing extraction rule in Keep by ID or name.",
+		Attributes:  attributes,
+	}
+}
+
+// ConfigValidators validates that the rule is looked up by exactly one of id or name.
+func (d *extractionRuleDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *extractionRuleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *extractionRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config extractionRuleDataModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading extraction rule", map[string]interface{}{
+		"id":   config.ID.ValueString(),
+		"name": config.Name.ValueString(),
+	})
+
+	rules, err := d.client.ListExtractionRules(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading extraction rules",
+			"Could not list extraction rules, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var matches []extractionRuleDataModel
+	for _, rule := range rules {
+		m := newExtractionRuleDataModel(rule)
+		if !config.ID.IsNull() && m.ID.ValueString() == config.ID.ValueString() {
+			matches = append(matches, m)
+		}
+		if !config.Name.IsNull() && m.Name.ValueString() == config.Name.ValueString() {
+			matches = append(matches, m)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Extraction rule not found",
+			fmt.Sprintf("No extraction rule matches %s.", extractionRuleLookupDescription(config)),
+		)
+		return
+	case 1:
+	default:
+		resp.Diagnostics.AddError(
+			"Multiple extraction rules found",
+			fmt.Sprintf("%d extraction rules match %s. Look the rule up by id instead.", len(matches), extractionRuleLookupDescription(config)),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &matches[0])
+	resp.Diagnostics.Append(diags...)
+}
+
+// extractionRuleLookupDescription describes the configured lookup for error messages.
+func extractionRuleLookupDescription(config extractionRuleDataModel) string {
+	if !config.ID.IsNull() {
+		return fmt.Sprintf("id %q", config.ID.ValueString())
+	}
+	return fmt.Sprintf("name %q", config.Name.ValueString())
+}
@@ -0,0 +1,72 @@
+// data_source_extraction_rule_test.go - Acceptance tests for the extraction_rule data source
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccExtractionRuleDataSource(t *testing.T) {
+	// Skip if running short tests
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	resourceName := "keep_extraction_rule.test"
+	byID := "data.keep_extraction_rule.by_id"
+	byName := "data.keep_extraction_rule.by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExtractionRuleDataSourceConfig("tf-acc-extraction-rule-ds"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(byID, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(byID, "regex", resourceName, "regex"),
+					resource.TestCheckResourceAttrPair(byID, "priority", resourceName, "priority"),
+					resource.TestCheckResourceAttrPair(byName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(byName, "attribute", resourceName, "attribute"),
+				),
+			},
+		},
+	})
+}
+
+func testAccExtractionRuleDataSourceConfig(name string) string {
+	apiURL := os.Getenv("KEEP_API_URL")
+	if apiURL == "" {
+		apiURL = "http://localhost:8080"
+	}
+
+	return fmt.Sprintf(`
+provider "keep" {
+  api_key = %q
+  api_url = %q
+}
+
+resource "keep_extraction_rule" "test" {
+  name      = %q
+  attribute = "message"
+  regex     = "(?P<host>host-\\d+)"
+  priority  = 7
+}
+
+data "keep_extraction_rule" "by_id" {
+  id = keep_extraction_rule.test.id
+}
+
+data "keep_extraction_rule" "by_name" {
+  name = keep_extraction_rule.test.name
+}
+`, os.Getenv("KEEP_API_KEY"), apiURL, name)
+}
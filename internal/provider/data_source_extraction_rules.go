@@ -0,0 +1,161 @@
+// data_source_extraction_rules.go - Data source implementation for listing KeepHQ extraction rules
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &extractionRulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &extractionRulesDataSource{}
+)
+
+// NewExtractionRulesDataSource is a helper function to simplify the provider implementation.
+func NewExtractionRulesDataSource() datasource.DataSource {
+	return &extractionRulesDataSource{}
+}
+
+// extractionRulesDataSource defines the data source implementation.
+type extractionRulesDataSource struct {
+	client *keep.Client
+}
+
+// extractionRulesDataSourceModel maps the data source schema data.
+type extractionRulesDataSourceModel struct {
+	ID        types.String              `tfsdk:"id"`
+	Pre       types.Bool                `tfsdk:"pre"`
+	Disabled  types.Bool                `tfsdk:"disabled"`
+	Attribute types.String              `tfsdk:"attribute"`
+	NameRegex types.String              `tfsdk:"name_regex"`
+	Rules     []extractionRuleDataModel `tfsdk:"rules"`
+}
+
+// Metadata returns the data source type name.
+func (d *extractionRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extraction_rules"
+}
+
+// Schema defines the schema for the data source.
+func (d *extractionRulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists extraction rules in Keep, optionally filtered. All filters must match for a rule to be returned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier for the data source.",
+				Computed:    true,
+			},
+			"pre": schema.BoolAttribute{
+				Description: "Only return pre-processing rules (true) or regular rules (false).",
+				Optional:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Only return disabled (true) or enabled (false) rules.",
+				Optional:    true,
+			},
+			"attribute": schema.StringAttribute{
+				Description: "Only return rules extracting this alert attribute.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return rules whose name matches this regular expression.",
+				Optional:    true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "The matching extraction rules, ordered as returned by the API.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: extractionRuleDataAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *extractionRulesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *extractionRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state extractionRulesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("Could not compile name_regex: %s", err),
+			)
+			return
+		}
+	}
+
+	rules, err := d.client.ListExtractionRules(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading extraction rules",
+			"Could not list extraction rules, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state.Rules = []extractionRuleDataModel{}
+	for _, rule := range rules {
+		m := newExtractionRuleDataModel(rule)
+		if !state.Pre.IsNull() && m.Pre.ValueBool() != state.Pre.ValueBool() {
+			continue
+		}
+		if !state.Disabled.IsNull() && m.Disabled.ValueBool() != state.Disabled.ValueBool() {
+			continue
+		}
+		if !state.Attribute.IsNull() && m.Attribute.ValueString() != state.Attribute.ValueString() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(m.Name.ValueString()) {
+			continue
+		}
+		state.Rules = append(state.Rules, m)
+	}
+
+	tflog.Debug(ctx, "Listed extraction rules", map[string]interface{}{
+		"total":    len(rules),
+		"matching": len(state.Rules),
+	})
+
+	state.ID = types.StringValue("extraction_rules")
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
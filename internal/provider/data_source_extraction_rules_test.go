@@ -0,0 +1,73 @@
+// data_source_extraction_rules_test.go - Acceptance tests for the extraction_rules data source
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccExtractionRulesDataSource(t *testing.T) {
+	// Skip if running short tests
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	dataSourceName := "data.keep_extraction_rules.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExtractionRulesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.name", "tf-acc-extraction-rules-pre"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.pre", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccExtractionRulesDataSourceConfig() string {
+	apiURL := os.Getenv("KEEP_API_URL")
+	if apiURL == "" {
+		apiURL = "http://localhost:8080"
+	}
+
+	return fmt.Sprintf(`
+provider "keep" {
+  api_key = %q
+  api_url = %q
+}
+
+resource "keep_extraction_rule" "pre" {
+  name      = "tf-acc-extraction-rules-pre"
+  attribute = "message"
+  regex     = "(?P<host>host-\\d+)"
+  pre       = true
+}
+
+resource "keep_extraction_rule" "post" {
+  name      = "tf-acc-extraction-rules-post"
+  attribute = "message"
+  regex     = "(?P<host>host-\\d+)"
+}
+
+data "keep_extraction_rules" "test" {
+  pre        = true
+  name_regex = "^tf-acc-extraction-rules-"
+
+  depends_on = [keep_extraction_rule.pre, keep_extraction_rule.post]
+}
+`, os.Getenv("KEEP_API_KEY"), apiURL)
+}
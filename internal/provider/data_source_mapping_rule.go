@@ -0,0 +1,236 @@
+// data_source_mapping_rule.go - Data source implementation for a single KeepHQ mapping rule
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &mappingRuleDataSource{}
+	_ datasource.DataSourceWithConfigure        = &mappingRuleDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &mappingRuleDataSource{}
+)
+
+// NewMappingRuleDataSource is a helper function to simplify the provider implementation.
+func NewMappingRuleDataSource() datasource.DataSource {
+	return &mappingRuleDataSource{}
+}
+
+// mappingRuleDataSource defines the data source implementation.
+type mappingRuleDataSource struct {
+	client *keep.Client
+}
+
+// mappingRuleDataModel maps a mapping rule returned by the API. It is the data
+// source model and the element type of keep_mapping_rules.
+type mappingRuleDataModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Priority    types.Int64  `tfsdk:"priority"`
+	Matchers    types.Map    `tfsdk:"matchers"`
+	Type        types.String `tfsdk:"type"`
+	RowsCount   types.Int64  `tfsdk:"rows_count"`
+	CreatedBy   types.String `tfsdk:"created_by"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+	UpdatedAt   types.String `tfsdk:"updated_at"`
+}
+
+// newMappingRuleDataModel converts a mapping rule from the API to the data source model.
+func newMappingRuleDataModel(rule *keep.MappingRule) mappingRuleDataModel {
+	m := mappingRuleDataModel{
+		ID:          optionalString(string(rule.ID)),
+		Name:        optionalString(rule.Name),
+		Description: optionalStringPointer(rule.Description),
+		Priority:    types.Int64Value(int64(rule.Priority)),
+		Matchers:    flattenMappingRuleMatchers(rule.Matchers),
+		Type:        optionalString(rule.Type),
+		RowsCount:   types.Int64Value(int64(len(rule.Rows))),
+		CreatedBy:   optionalString(rule.CreatedBy),
+		CreatedAt:   optionalString(rule.CreatedAt),
+		UpdatedAt:   optionalStringPointer(rule.LastUpdatedAt),
+	}
+	// Keep calls the update timestamp last_updated_at; accept updated_at as well
+	if m.UpdatedAt.IsNull() {
+		m.UpdatedAt = optionalStringPointer(rule.UpdatedAt)
+	}
+	return m
+}
+
+// mappingRuleDataAttributes returns the computed attributes describing a mapping rule.
+func mappingRuleDataAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The unique identifier of the mapping rule.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the mapping rule.",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "A description of what the mapping rule does.",
+			Computed:    true,
+		},
+		"priority": schema.Int64Attribute{
+			Description: "The priority of the mapping rule. Lower numbers have higher priority.",
+			Computed:    true,
+		},
+		"matchers": schema.MapAttribute{
+			Description: "The matchers that determine when this rule is applied.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"type": schema.StringAttribute{
+			Description: "The type of the mapping rule, such as csv or topology.",
+			Computed:    true,
+		},
+		"rows_count": schema.Int64Attribute{
+			Description: "The number of CSV rows in the mapping rule.",
+			Computed:    true,
+		},
+		"created_by": schema.StringAttribute{
+			Description: "Who created the mapping rule.",
+			Computed:    true,
+		},
+		"created_at": schema.StringAttribute{
+			Description: "When the mapping rule was created.",
+			Computed:    true,
+		},
+		"updated_at": schema.StringAttribute{
+			Description: "When the mapping rule was last updated.",
+			Computed:    true,
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *mappingRuleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mapping_rule"
+}
+
+// Schema defines the schema for the data source.
+func (d *mappingRuleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := mappingRuleDataAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "The unique identifier of the mapping rule. Exactly one of id or name must be set.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "The name of the mapping rule. Exactly one of id or name must be set; the name must match a single rule.",
+		Optional:    true,
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing mapping rule in Keep by ID or name.",
+		Attributes:  attributes,
+	}
+}
+
+// ConfigValidators validates that the rule is looked up by exactly one of id or name.
+func (d *mappingRuleDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *mappingRuleDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *mappingRuleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config mappingRuleDataModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading mapping rule", map[string]interface{}{
+		"id":   config.ID.ValueString(),
+		"name": config.Name.ValueString(),
+	})
+
+	// Look up by ID directly
+	if !config.ID.IsNull() {
+		rule, err := d.client.GetMappingRule(ctx, config.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading mapping rule",
+				fmt.Sprintf("Could not read mapping rule ID %s: %s", config.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		state := newMappingRuleDataModel(rule)
+		diags = resp.State.Set(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	// Look up by name, which must be unambiguous
+	rules, err := d.client.ListMappingRules(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading mapping rules",
+			"Could not list mapping rules, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	var matches []mappingRuleDataModel
+	for i := range rules {
+		if rules[i].Name == config.Name.ValueString() {
+			matches = append(matches, newMappingRuleDataModel(&rules[i]))
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Mapping rule not found",
+			fmt.Sprintf("No mapping rule matches name %q.", config.Name.ValueString()),
+		)
+		return
+	case 1:
+	default:
+		resp.Diagnostics.AddError(
+			"Multiple mapping rules found",
+			fmt.Sprintf("%d mapping rules match name %q. Look the rule up by id instead.", len(matches), config.Name.ValueString()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &matches[0])
+	resp.Diagnostics.Append(diags...)
+}
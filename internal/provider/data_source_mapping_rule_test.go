@@ -0,0 +1,78 @@
+// data_source_mapping_rule_test.go - Acceptance tests for the mapping_rule data source
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMappingRuleDataSource(t *testing.T) {
+	// Skip if running short tests
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	resourceName := "keep_mapping_rule.test"
+	byID := "data.keep_mapping_rule.by_id"
+	byName := "data.keep_mapping_rule.by_name"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMappingRuleDataSourceConfig("tf-acc-mapping-rule-ds"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(byID, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(byID, "priority", resourceName, "priority"),
+					resource.TestCheckResourceAttr(byID, "rows_count", "2"),
+					resource.TestCheckResourceAttrPair(byName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(byName, "created_by"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMappingRuleDataSourceConfig(name string) string {
+	apiURL := os.Getenv("KEEP_API_URL")
+	if apiURL == "" {
+		apiURL = "http://localhost:8080"
+	}
+
+	return fmt.Sprintf(`
+provider "keep" {
+  api_key = %q
+  api_url = %q
+}
+
+resource "keep_mapping_rule" "test" {
+  name     = %q
+  priority = 3
+  matchers = {
+    "service" = "service"
+  }
+  csv_data = <<-EOT
+service,owner
+api,team-a
+web,team-b
+EOT
+}
+
+data "keep_mapping_rule" "by_id" {
+  id = keep_mapping_rule.test.id
+}
+
+data "keep_mapping_rule" "by_name" {
+  name = keep_mapping_rule.test.name
+}
+`, os.Getenv("KEEP_API_KEY"), apiURL, name)
+}
@@ -0,0 +1,137 @@
+// data_source_mapping_rules.go - Data source implementation for listing KeepHQ mapping rules
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &mappingRulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &mappingRulesDataSource{}
+)
+
+// NewMappingRulesDataSource is a helper function to simplify the provider implementation.
+func NewMappingRulesDataSource() datasource.DataSource {
+	return &mappingRulesDataSource{}
+}
+
+// mappingRulesDataSource defines the data source implementation.
+type mappingRulesDataSource struct {
+	client *keep.Client
+}
+
+// mappingRulesDataSourceModel maps the data source schema data.
+type mappingRulesDataSourceModel struct {
+	ID        types.String           `tfsdk:"id"`
+	NameRegex types.String           `tfsdk:"name_regex"`
+	Rules     []mappingRuleDataModel `tfsdk:"rules"`
+}
+
+// Metadata returns the data source type name.
+func (d *mappingRulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mapping_rules"
+}
+
+// Schema defines the schema for the data source.
+func (d *mappingRulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists mapping rules in Keep, optionally filtered by name.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier for the data source.",
+				Computed:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return rules whose name matches this regular expression.",
+				Optional:    true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Description: "The matching mapping rules, ordered as returned by the API.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: mappingRuleDataAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *mappingRulesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *mappingRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state mappingRulesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("Could not compile name_regex: %s", err),
+			)
+			return
+		}
+	}
+
+	rules, err := d.client.ListMappingRules(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading mapping rules",
+			"Could not list mapping rules, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state.Rules = []mappingRuleDataModel{}
+	for i := range rules {
+		m := newMappingRuleDataModel(&rules[i])
+		if nameRegex != nil && !nameRegex.MatchString(m.Name.ValueString()) {
+			continue
+		}
+		state.Rules = append(state.Rules, m)
+	}
+
+	tflog.Debug(ctx, "Listed mapping rules", map[string]interface{}{
+		"total":    len(rules),
+		"matching": len(state.Rules),
+	})
+
+	state.ID = types.StringValue("mapping_rules")
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,70 @@
+// data_source_mapping_rules_test.go - Acceptance tests for the mapping_rules data source
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccMappingRulesDataSource(t *testing.T) {
+	// Skip if running short tests
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	dataSourceName := "data.keep_mapping_rules.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMappingRulesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.name", "tf-acc-mapping-rules-cmdb"),
+					resource.TestCheckResourceAttr(dataSourceName, "rules.0.rows_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMappingRulesDataSourceConfig() string {
+	apiURL := os.Getenv("KEEP_API_URL")
+	if apiURL == "" {
+		apiURL = "http://localhost:8080"
+	}
+
+	return fmt.Sprintf(`
+provider "keep" {
+  api_key = %q
+  api_url = %q
+}
+
+resource "keep_mapping_rule" "test" {
+  name     = "tf-acc-mapping-rules-cmdb"
+  matchers = {
+    "service" = "service"
+  }
+  csv_data = <<-EOT
+service,owner
+api,team-a
+EOT
+}
+
+data "keep_mapping_rules" "test" {
+  name_regex = "^tf-acc-mapping-rules-"
+
+  depends_on = [keep_mapping_rule.test]
+}
+`, os.Getenv("KEEP_API_KEY"), apiURL)
+}
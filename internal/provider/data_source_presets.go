@@ -0,0 +1,252 @@
+// data_source_presets.go - Data source implementation for listing KeepHQ presets
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &presetsDataSource{}
+	_ datasource.DataSourceWithConfigure = &presetsDataSource{}
+)
+
+// NewPresetsDataSource is a helper function to simplify the provider implementation.
+func NewPresetsDataSource() datasource.DataSource {
+	return &presetsDataSource{}
+}
+
+// presetsDataSource defines the data source implementation.
+type presetsDataSource struct {
+	client *keep.Client
+}
+
+// presetsDataSourceModel maps the data source schema data.
+type presetsDataSourceModel struct {
+	ID            types.String      `tfsdk:"id"`
+	NameRegex     types.String      `tfsdk:"name_regex"`
+	Tag           types.String      `tfsdk:"tag"`
+	IncludeStatic types.Bool        `tfsdk:"include_static"`
+	Presets       []presetDataModel `tfsdk:"presets"`
+}
+
+// presetDataModel maps a preset returned by the API.
+type presetDataModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	CELQuery               types.String `tfsdk:"cel_query"`
+	SQLQuery               types.String `tfsdk:"sql_query"`
+	IsPrivate              types.Bool   `tfsdk:"is_private"`
+	IsNoisy                types.Bool   `tfsdk:"is_noisy"`
+	ShouldDoNoiseNow       types.Bool   `tfsdk:"should_do_noise_now"`
+	CounterShowsFiringOnly types.Bool   `tfsdk:"counter_shows_firing_only"`
+	GroupBy                types.String `tfsdk:"group_by"`
+	Static                 types.Bool   `tfsdk:"static"`
+	Tags                   []string     `tfsdk:"tags"`
+	CreatedBy              types.String `tfsdk:"created_by"`
+}
+
+// newPresetDataModel converts a preset from the API to the data source model.
+func newPresetDataModel(p keep.Preset) presetDataModel {
+	tags := make([]string, 0, len(p.Tags))
+	for _, tag := range p.Tags {
+		tags = append(tags, tag.Name)
+	}
+
+	return presetDataModel{
+		ID:                     types.StringValue(p.ID),
+		Name:                   types.StringValue(p.Name),
+		CELQuery:               types.StringValue(presetString(p.Option(presetOptionCEL))),
+		SQLQuery:               optionalString(presetSQL(p.Option(presetOptionSQL))),
+		IsPrivate:              types.BoolValue(p.IsPrivate),
+		IsNoisy:                types.BoolValue(p.IsNoisy),
+		ShouldDoNoiseNow:       types.BoolValue(p.ShouldDoNoiseNow),
+		CounterShowsFiringOnly: types.BoolValue(p.CounterShowsFiringOnly),
+		GroupBy:                optionalString(presetString(p.Option(presetOptionGroupBy))),
+		Static:                 types.BoolValue(p.Static),
+		Tags:                   tags,
+		CreatedBy:              optionalString(p.CreatedBy),
+	}
+}
+
+// hasTag reports whether the preset has a tag with the given name.
+func (m presetDataModel) hasTag(name string) bool {
+	for _, tag := range m.Tags {
+		if tag == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Metadata returns the data source type name.
+func (d *presetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_presets"
+}
+
+// Schema defines the schema for the data source.
+func (d *presetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists presets in Keep, optionally filtered by name or tag.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier for the data source.",
+				Computed:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return presets whose name matches this regular expression.",
+				Optional:    true,
+			},
+			"tag": schema.StringAttribute{
+				Description: "Only return presets with a tag of this name.",
+				Optional:    true,
+			},
+			"include_static": schema.BoolAttribute{
+				Description: "Whether to include the static presets built into Keep, such as feed. Defaults to false.",
+				Optional:    true,
+			},
+			"presets": schema.ListNestedAttribute{
+				Description: "The matching presets, ordered as returned by the API.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The unique identifier of the preset.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the preset.",
+							Computed:    true,
+						},
+						"cel_query": schema.StringAttribute{
+							Description: "CEL expression selecting the alerts shown by the preset.",
+							Computed:    true,
+						},
+						"sql_query": schema.StringAttribute{
+							Description: "SQL form of the query, if saved.",
+							Computed:    true,
+						},
+						"is_private": schema.BoolAttribute{
+							Description: "Whether the preset is only visible to its creator.",
+							Computed:    true,
+						},
+						"is_noisy": schema.BoolAttribute{
+							Description: "Whether Keep plays a sound while the preset has firing alerts.",
+							Computed:    true,
+						},
+						"should_do_noise_now": schema.BoolAttribute{
+							Description: "Whether the preset is noisy and currently has firing alerts.",
+							Computed:    true,
+						},
+						"counter_shows_firing_only": schema.BoolAttribute{
+							Description: "Whether the alert counter of the preset only counts firing alerts.",
+							Computed:    true,
+						},
+						"group_by": schema.StringAttribute{
+							Description: "Alert field the preset groups alerts by.",
+							Computed:    true,
+						},
+						"static": schema.BoolAttribute{
+							Description: "Whether the preset is built into Keep.",
+							Computed:    true,
+						},
+						"tags": schema.ListAttribute{
+							Description: "Names of the tags attached to the preset.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"created_by": schema.StringAttribute{
+							Description: "Who created the preset.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *presetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *presetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state presetsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				fmt.Sprintf("Could not compile name_regex: %s", err),
+			)
+			return
+		}
+	}
+
+	presets, err := d.client.ListPresets(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading presets",
+			"Could not list presets, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state.Presets = []presetDataModel{}
+	for _, preset := range presets {
+		if preset.Static && !state.IncludeStatic.ValueBool() {
+			continue
+		}
+		m := newPresetDataModel(preset)
+		if nameRegex != nil && !nameRegex.MatchString(preset.Name) {
+			continue
+		}
+		if !state.Tag.IsNull() && !m.hasTag(state.Tag.ValueString()) {
+			continue
+		}
+		state.Presets = append(state.Presets, m)
+	}
+
+	tflog.Debug(ctx, "Listed presets", map[string]interface{}{
+		"total":    len(presets),
+		"matching": len(state.Presets),
+	})
+
+	state.ID = types.StringValue("presets")
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
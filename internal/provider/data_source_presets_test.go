@@ -0,0 +1,44 @@
+// data_source_presets_test.go - Acceptance tests for the presets data source
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccPresetsDataSource(t *testing.T) {
+	// Skip if running short tests
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	dataSourceName := "data.keep_presets.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPresetResourceConfig(`severity == "critical"`, false) + `
+data "keep_presets" "test" {
+  tag = "tf-acc"
+
+  depends_on = [keep_preset.test]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "presets.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "presets.0.name", "tf-acc-preset"),
+					resource.TestCheckResourceAttr(dataSourceName, "presets.0.group_by", "service"),
+				),
+			},
+		},
+	})
+}
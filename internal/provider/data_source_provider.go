@@ -0,0 +1,148 @@
+// data_source_provider.go - Data source implementation for a single KeepHQ provider
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &providerDataSource{}
+	_ datasource.DataSourceWithConfigure        = &providerDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &providerDataSource{}
+)
+
+// NewProviderDataSource is a helper function to simplify the provider implementation.
+func NewProviderDataSource() datasource.DataSource {
+	return &providerDataSource{}
+}
+
+// providerDataSource defines the data source implementation.
+type providerDataSource struct {
+	client *keep.Client
+}
+
+// Metadata returns the data source type name.
+func (d *providerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_provider"
+}
+
+// Schema defines the schema for the data source.
+func (d *providerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := providerDataAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "The unique ID of the provider.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "The display name of the provider.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["type"] = schema.StringAttribute{
+		Description: "The type of the provider (e.g., 'datadog', 'pagerduty').",
+		Optional:    true,
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single KeepHQ provider by ID, name and/or type. The lookup fails unless exactly one provider matches.",
+		Attributes:  attributes,
+	}
+}
+
+// ConfigValidators validates that at least one lookup attribute is set.
+func (d *providerDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("type"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *providerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *providerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config providerDataModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providers, err := d.client.ListProviders(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading providers",
+			"Could not list providers, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	filter := providerFilter{
+		ID:        config.ID,
+		Name:      config.Name,
+		Type:      config.Type,
+		Installed: config.Installed,
+	}
+	matches := filterProviders(providers, filter)
+
+	tflog.Debug(ctx, "Looked up provider", map[string]interface{}{
+		"id":      config.ID.ValueString(),
+		"name":    config.Name.ValueString(),
+		"type":    config.Type.ValueString(),
+		"matches": len(matches),
+	})
+
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Provider not found",
+			fmt.Sprintf("No provider matches %s.", filter.describe()),
+		)
+		return
+	case 1:
+	default:
+		ids := make([]string, 0, len(matches))
+		for _, m := range matches {
+			ids = append(ids, m.ID.ValueString())
+		}
+		resp.Diagnostics.AddError(
+			"Multiple providers found",
+			fmt.Sprintf("%d providers match %s: %s. Narrow the lookup, for example by id.", len(matches), filter.describe(), strings.Join(ids, ", ")),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &matches[0])
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,230 @@
+// data_source_providers.go - Data source implementation for listing KeepHQ providers
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &providersDataSource{}
+	_ datasource.DataSourceWithConfigure = &providersDataSource{}
+)
+
+// NewProvidersDataSource is a helper function to simplify the provider implementation.
+func NewProvidersDataSource() datasource.DataSource {
+	return &providersDataSource{}
+}
+
+// providersDataSource defines the data source implementation.
+type providersDataSource struct {
+	client *keep.Client
+}
+
+// providersDataSourceModel maps the data source schema data.
+type providersDataSourceModel struct {
+	ID        types.String        `tfsdk:"id"`
+	Name      types.String        `tfsdk:"name"`
+	Type      types.String        `tfsdk:"type"`
+	Installed types.Bool          `tfsdk:"installed"`
+	Providers []providerDataModel `tfsdk:"providers"`
+}
+
+// providerDataModel maps a provider returned by the API. It is the keep_provider
+// data source model and the element type of keep_providers. The provider
+// configuration is deliberately not exposed as it holds credentials.
+type providerDataModel struct {
+	ID                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Type              types.String `tfsdk:"type"`
+	Installed         types.Bool   `tfsdk:"installed"`
+	LastAlertReceived types.String `tfsdk:"last_alert_received"`
+}
+
+// newProviderDataModel converts a provider from the API to the data source model.
+func newProviderDataModel(p keep.Provider) providerDataModel {
+	return providerDataModel{
+		ID:                types.StringValue(p.ID),
+		Name:              types.StringValue(p.Name),
+		Type:              types.StringValue(p.Type),
+		Installed:         types.BoolValue(p.Installed),
+		LastAlertReceived: optionalString(p.LastAlertReceived),
+	}
+}
+
+// providerFilter selects providers by the attributes set in a data source configuration.
+// Null attributes match any provider.
+type providerFilter struct {
+	ID        types.String
+	Name      types.String
+	Type      types.String
+	Installed types.Bool
+}
+
+// matches reports whether p satisfies all of the filter's set attributes.
+func (f providerFilter) matches(p keep.Provider) bool {
+	if !f.ID.IsNull() && p.ID != f.ID.ValueString() {
+		return false
+	}
+	if !f.Name.IsNull() && p.Name != f.Name.ValueString() {
+		return false
+	}
+	if !f.Type.IsNull() && p.Type != f.Type.ValueString() {
+		return false
+	}
+	if !f.Installed.IsNull() && p.Installed != f.Installed.ValueBool() {
+		return false
+	}
+	return true
+}
+
+// describe returns the set filter attributes for error messages.
+func (f providerFilter) describe() string {
+	var parts []string
+	if !f.ID.IsNull() {
+		parts = append(parts, fmt.Sprintf("id %q", f.ID.ValueString()))
+	}
+	if !f.Name.IsNull() {
+		parts = append(parts, fmt.Sprintf("name %q", f.Name.ValueString()))
+	}
+	if !f.Type.IsNull() {
+		parts = append(parts, fmt.Sprintf("type %q", f.Type.ValueString()))
+	}
+	return strings.Join(parts, " and ")
+}
+
+// filterProviders returns the providers matching the filter, converted to the data source model.
+func filterProviders(providers []keep.Provider, filter providerFilter) []providerDataModel {
+	matches := []providerDataModel{}
+	for _, p := range providers {
+		if filter.matches(p) {
+			matches = append(matches, newProviderDataModel(p))
+		}
+	}
+	return matches
+}
+
+// providerDataAttributes returns the computed attributes describing a provider.
+func providerDataAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The unique ID of the provider.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The display name of the provider.",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "The type of the provider (e.g., 'datadog', 'pagerduty').",
+			Computed:    true,
+		},
+		"installed": schema.BoolAttribute{
+			Description: "Whether the provider is installed and ready to use.",
+			Computed:    true,
+		},
+		"last_alert_received": schema.StringAttribute{
+			Description: "Timestamp of the last alert received from this provider.",
+			Computed:    true,
+		},
+	}
+}
+
+// Metadata returns the data source type name.
+func (d *providersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_providers"
+}
+
+// Schema defines the schema for the data source.
+func (d *providersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists KeepHQ providers, optionally filtered by name, type and installation status. All filters must match for a provider to be returned.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier for the data source.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Only return providers with this display name.",
+				Optional:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only return providers of this type (e.g., 'datadog').",
+				Optional:    true,
+			},
+			"installed": schema.BoolAttribute{
+				Description: "Only return installed (true) or not installed (false) providers.",
+				Optional:    true,
+			},
+			"providers": schema.ListNestedAttribute{
+				Description: "The matching providers: installed providers first, followed by the provider types available for installation.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: providerDataAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *providersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *providersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state providersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	providers, err := d.client.ListProviders(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading providers",
+			"Could not list providers, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	state.Providers = filterProviders(providers, providerFilter{
+		ID:        types.StringNull(),
+		Name:      state.Name,
+		Type:      state.Type,
+		Installed: state.Installed,
+	})
+
+	tflog.Debug(ctx, "Listed providers", map[string]interface{}{
+		"total":    len(providers),
+		"matching": len(state.Providers),
+	})
+
+	state.ID = types.StringValue("providers")
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,126 @@
+// data_source_providers_test.go - Tests for the providers and provider data sources
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+func TestFilterProviders(t *testing.T) {
+	providers := []keep.Provider{
+		{ID: "1", Name: "prod-datadog", Type: "datadog", Installed: true},
+		{ID: "2", Name: "staging-datadog", Type: "datadog", Installed: false},
+		{ID: "3", Name: "oncall", Type: "pagerduty", Installed: true},
+	}
+
+	tests := []struct {
+		name    string
+		filter  providerFilter
+		wantIDs []string
+	}{
+		{
+			name:    "no filters",
+			filter:  providerFilter{},
+			wantIDs: []string{"1", "2", "3"},
+		},
+		{
+			name:    "by type",
+			filter:  providerFilter{Type: types.StringValue("datadog")},
+			wantIDs: []string{"1", "2"},
+		},
+		{
+			name:    "by type and installed",
+			filter:  providerFilter{Type: types.StringValue("datadog"), Installed: types.BoolValue(true)},
+			wantIDs: []string{"1"},
+		},
+		{
+			name:    "by name",
+			filter:  providerFilter{Name: types.StringValue("oncall")},
+			wantIDs: []string{"3"},
+		},
+		{
+			name:    "no match",
+			filter:  providerFilter{ID: types.StringValue("4")},
+			wantIDs: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := filterProviders(providers, tt.filter)
+			if len(got) != len(tt.wantIDs) {
+				t.Fatalf("got %d providers, want %d", len(got), len(tt.wantIDs))
+			}
+			for i, p := range got {
+				if p.ID.ValueString() != tt.wantIDs[i] {
+					t.Errorf("provider %d: id = %s, want %s", i, p.ID.ValueString(), tt.wantIDs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAccProvidersDataSource(t *testing.T) {
+	// Skip if running short tests
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	resourceName := "keep_provider.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvidersDataSourceConfig("tf-acc-providers-ds"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.keep_providers.test", "providers.#", "1"),
+					resource.TestCheckResourceAttrPair("data.keep_providers.test", "providers.0.id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair("data.keep_provider.test", "id", resourceName, "id"),
+					resource.TestCheckResourceAttr("data.keep_provider.test", "type", "squadcast"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProvidersDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+provider "keep" {
+  api_key = "%s"
+  api_url = "%s"
+}
+
+resource "keep_provider" "test" {
+  name = %q
+  type = "squadcast"
+  config = {
+    service_region = "US"
+  }
+}
+
+data "keep_providers" "test" {
+  name = keep_provider.test.name
+  type = "squadcast"
+}
+
+data "keep_provider" "test" {
+  name = keep_provider.test.name
+}
+`,
+		os.Getenv("KEEP_API_KEY"),
+		os.Getenv("KEEP_API_URL"),
+		name,
+	)
+}
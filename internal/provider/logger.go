@@ -0,0 +1,27 @@
+// logger.go - Routes the KeepHQ API client's log messages to tflog
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+// tflogLogger implements keep.Logger on top of tflog, so client messages show
+// up in the provider logs enabled with TF_LOG
+type tflogLogger struct{}
+
+var _ keep.Logger = tflogLogger{}
+
+func (tflogLogger) Debug(ctx context.Context, msg string, fields map[string]interface{}) {
+	tflog.Debug(ctx, msg, fields)
+}
+
+func (tflogLogger) Warn(ctx context.Context, msg string, fields map[string]interface{}) {
+	tflog.Warn(ctx, msg, fields)
+}
+
+func (tflogLogger) Error(ctx context.Context, msg string, fields map[string]interface{}) {
+	tflog.Error(ctx, msg, fields)
+}
@@ -4,15 +4,21 @@ package provider
 import (
 	"context"
 	"fmt"
+	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/keephq/terraform-provider-keep/internal/client"
+	"github.com/keephq/terraform-provider-keep/keep"
 )
 
 // Ensure the implementation satisfies the expected interfaces
@@ -47,11 +53,132 @@ func (p *keepProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 			"api_key": schema.StringAttribute{
 				Optional:    true,
 				Sensitive:   true,
-				Description: "The API key for KeepHQ API. Can also be set with the KEEP_API_KEY environment variable.",
+				Description: "The API key for KeepHQ API. Can also be set with the KEEP_API_KEY environment variable or the api_key entry of the selected profile.",
 			},
 			"api_url": schema.StringAttribute{
 				Optional:    true,
-				Description: "The URL of the KeepHQ API. Defaults to http://localhost:8080. Can also be set with the KEEP_API_URL environment variable.",
+				Description: "The URL of the KeepHQ API. Defaults to http://localhost:8080. Can also be set with the KEEP_API_URL environment variable or the api_url entry of the selected profile. Trailing slashes are removed.",
+			},
+			"config_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to an INI-style profile file holding api_key and api_url entries per [profile] section. Defaults to ~/.keep/credentials. Can also be set with the KEEP_CONFIG_FILE environment variable.",
+			},
+			"profile": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of the profile to read from the profile file. Defaults to \"default\". Can also be set with the KEEP_PROFILE environment variable. Values from the provider configuration and environment variables take precedence over the profile.",
+			},
+			"auth_type": schema.StringAttribute{
+				Optional:    true,
+				Description: "Authentication method used against the KeepHQ API: api_key (X-API-KEY header), bearer (static bearer token), oauth2_client_credentials (tokens from an OAuth2 token endpoint such as Keycloak or Auth0, refreshed automatically) or basic (HTTP basic authentication). Defaults to api_key. Can also be set with the KEEP_AUTH_TYPE environment variable.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(authTypes...),
+				},
+			},
+			"bearer_token": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Static bearer token used when auth_type is bearer. Can also be set with the KEEP_BEARER_TOKEN environment variable.",
+			},
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Description: "Username used when auth_type is basic. Can also be set with the KEEP_USERNAME environment variable.",
+			},
+			"password": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password used when auth_type is basic. Can also be set with the KEEP_PASSWORD environment variable.",
+			},
+			"token_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "OAuth2 token endpoint used when auth_type is oauth2_client_credentials, e.g. https://keycloak.example.com/realms/keep/protocol/openid-connect/token. Can also be set with the KEEP_OAUTH2_TOKEN_URL environment variable.",
+			},
+			"client_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "OAuth2 client ID used when auth_type is oauth2_client_credentials. Can also be set with the KEEP_OAUTH2_CLIENT_ID environment variable.",
+			},
+			"client_secret": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "OAuth2 client secret used when auth_type is oauth2_client_credentials. Can also be set with the KEEP_OAUTH2_CLIENT_SECRET environment variable.",
+			},
+			"scopes": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "OAuth2 scopes requested when auth_type is oauth2_client_credentials. Can also be set with the KEEP_OAUTH2_SCOPES environment variable as a space-separated list.",
+			},
+			"audience": schema.StringAttribute{
+				Optional:    true,
+				Description: "OAuth2 audience requested when auth_type is oauth2_client_credentials, required by some identity providers such as Auth0. Can also be set with the KEEP_OAUTH2_AUDIENCE environment variable.",
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM encoded CA bundle used to verify the KeepHQ API certificate, in addition to the system roots. Conflicts with ca_cert_pem. Can also be set with the KEEP_CA_CERT_FILE environment variable.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_pem")),
+				},
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded CA bundle used to verify the KeepHQ API certificate, in addition to the system roots. Conflicts with ca_cert_file.",
+			},
+			"client_cert": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM encoded client certificate, or the path to one, for mutual TLS. Requires client_key. Can also be set with the KEEP_CLIENT_CERT environment variable.",
+			},
+			"client_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key of the client certificate, or the path to one. Requires client_cert. Can also be set with the KEEP_CLIENT_KEY environment variable.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip verification of the KeepHQ API server certificate. Only use for testing. Can also be set with the KEEP_INSECURE_SKIP_VERIFY environment variable.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of an HTTP(S) or SOCKS5 proxy to reach the KeepHQ API through. When unset, the HTTPS_PROXY, HTTP_PROXY and NO_PROXY environment variables apply. Can also be set with the KEEP_PROXY_URL environment variable.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of retries for requests that fail with a transient error (429, 502, 503, 504 or a network failure). Non-idempotent requests such as alert ingestion are only retried when the server did not process them. Set to 0 to disable retries. Defaults to 3.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Optional:    true,
+				Description: "Minimum time to wait before retrying a failed request, as a duration such as \"500ms\" or \"1s\". The wait doubles on each retry. Defaults to 1s.",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum time to wait between retries, as a duration such as \"30s\". A Retry-After header sent by the server is honored up to this value. Defaults to 30s.",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Timeout for a single API request attempt, as a duration such as \"2m\". Raise it for large mapping rule uploads; resource timeouts blocks bound the whole operation including retries. Defaults to 30s.",
+				Validators: []validator.String{
+					durationValidator{},
+				},
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Maximum number of API requests in flight at once, shared by all resources and data sources of the provider. Requests beyond it wait for a slot. Unlimited by default.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum rate of API requests, retries included, shared by all resources and data sources of the provider. Fractions such as 0.5 are allowed. Set to 0 or leave unset for no limit.",
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
 			},
 		},
 	}
@@ -84,31 +211,91 @@ func (p *keepProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 	}
 
+	if config.APIURL.IsUnknown() {
+		errMsg := "The provider cannot create the KeepHQ API client as there is an unknown configuration value for the KeepHQ API URL. " +
+			"Either target apply the source of the value first, set the value statically in the configuration, or use the KEEP_API_URL environment variable."
+		tflog.Error(ctx, errMsg)
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_url"),
+			"Unknown KeepHQ API URL",
+			errMsg,
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, "Configuration has errors, cannot create client")
 		return
 	}
 
-	// Default values
-	apiKey := ""
-	apiURL := "http://localhost:8080"
+	// Merge the configuration with environment variables and the profile file
+	resolved, diags := resolveProviderConfig(config, os.Getenv)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, "Configuration has errors, cannot create client")
+		return
+	}
+
+	apiURL := resolved.APIURL
 
-	if !config.APIKey.IsNull() {
-		apiKey = config.APIKey.ValueString()
+	// Retry policy, starting from the client defaults
+	retryPolicy := keep.DefaultRetryPolicy()
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		retryPolicy.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.RetryWaitMin.IsNull() && !config.RetryWaitMin.IsUnknown() {
+		retryPolicy.WaitMin, _ = time.ParseDuration(config.RetryWaitMin.ValueString())
+	}
+	if !config.RetryWaitMax.IsNull() && !config.RetryWaitMax.IsUnknown() {
+		retryPolicy.WaitMax, _ = time.ParseDuration(config.RetryWaitMax.ValueString())
+	}
+	if retryPolicy.WaitMin > retryPolicy.WaitMax {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid Retry Configuration",
+			fmt.Sprintf("retry_wait_min (%s) must not be greater than retry_wait_max (%s).", retryPolicy.WaitMin, retryPolicy.WaitMax),
+		)
+		return
 	}
 
-	if !config.APIURL.IsNull() {
-		apiURL = config.APIURL.ValueString()
+	requestTimeout := keep.DefaultTimeout
+	if !config.RequestTimeout.IsNull() && !config.RequestTimeout.IsUnknown() {
+		requestTimeout, _ = time.ParseDuration(config.RequestTimeout.ValueString())
 	}
 
-	tflog.Debug(ctx, "Provider configuration", 
+	// Request limits, unlimited unless configured
+	maxConcurrentRequests := int(config.MaxConcurrentRequests.ValueInt64())
+	requestsPerSecond := config.RequestsPerSecond.ValueFloat64()
+
+	tflog.Debug(ctx, "Provider configuration",
 		map[string]interface{}{
-			"api_key_set": apiKey != "",
-			"api_url":     apiURL,
-	})
+			"auth_type":       resolved.AuthType,
+			"api_key_set":     resolved.APIKey != "",
+			"api_key_source":  resolved.APIKeySource,
+			"api_url":         apiURL,
+			"api_url_source":  resolved.APIURLSource,
+			"profile_file":    resolved.ProfileFile,
+			"profile":         resolved.Profile,
+			"max_retries":     retryPolicy.MaxRetries,
+			"retry_wait_min":  retryPolicy.WaitMin.String(),
+			"retry_wait_max":  retryPolicy.WaitMax.String(),
+			"request_timeout": requestTimeout.String(),
+			"max_concurrent":  maxConcurrentRequests,
+			"rate_limit":      requestsPerSecond,
+		})
 
 	// Create a new KeepHQ client using the configuration values
-	client, err := client.NewClient(apiURL, apiKey)
+	client, err := keep.NewClientFromConfig(keep.Config{
+		BaseURL:     apiURL,
+		Auth:        resolved.Auth,
+		RetryPolicy: &retryPolicy,
+		TLS:         resolved.TLS,
+		ProxyURL:    resolved.ProxyURL,
+		Timeout:     requestTimeout,
+
+		MaxConcurrentRequests: maxConcurrentRequests,
+		RequestsPerSecond:     requestsPerSecond,
+		Logger:                tflogLogger{},
+	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to create KeepHQ client",
@@ -126,7 +313,13 @@ func (p *keepProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 // DataSources defines the data sources implemented in the provider.
 func (p *keepProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		// Add data sources here
+		NewExtractionRuleDataSource,
+		NewExtractionRulesDataSource,
+		NewMappingRuleDataSource,
+		NewMappingRulesDataSource,
+		NewProviderDataSource,
+		NewProvidersDataSource,
+		NewPresetsDataSource,
 	}
 }
 
@@ -137,11 +330,44 @@ func (p *keepProvider) Resources(_ context.Context) []func() resource.Resource {
 		NewExtractionRuleResource,
 		NewAlertResource,
 		NewMappingRuleResource,
+		NewWorkflowResource,
+		NewIncidentResource,
+		NewCorrelationRuleResource,
+		NewDeduplicationRuleResource,
+		NewMaintenanceWindowResource,
+		NewPresetResource,
+		NewTopologyServiceResource,
+		NewTopologyApplicationResource,
+		NewTopologyDependencyResource,
 	}
 }
 
 // providerModel maps provider schema data to a Go type
 type providerModel struct {
-	APIKey types.String `tfsdk:"api_key"`
-	APIURL types.String `tfsdk:"api_url"`
+	APIKey             types.String `tfsdk:"api_key"`
+	APIURL             types.String `tfsdk:"api_url"`
+	ConfigFile         types.String `tfsdk:"config_file"`
+	Profile            types.String `tfsdk:"profile"`
+	AuthType           types.String `tfsdk:"auth_type"`
+	BearerToken        types.String `tfsdk:"bearer_token"`
+	Username           types.String `tfsdk:"username"`
+	Password           types.String `tfsdk:"password"`
+	TokenURL           types.String `tfsdk:"token_url"`
+	ClientID           types.String `tfsdk:"client_id"`
+	ClientSecret       types.String `tfsdk:"client_secret"`
+	Scopes             types.List   `tfsdk:"scopes"`
+	Audience           types.String `tfsdk:"audience"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	ClientCert         types.String `tfsdk:"client_cert"`
+	ClientKey          types.String `tfsdk:"client_key"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	ProxyURL           types.String `tfsdk:"proxy_url"`
+	MaxRetries         types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin       types.String `tfsdk:"retry_wait_min"`
+	RetryWaitMax       types.String `tfsdk:"retry_wait_max"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
+
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
 }
@@ -0,0 +1,447 @@
+// provider_config.go - Resolution of provider settings from HCL, environment and profile files
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+const (
+	// envAPIKey is the environment variable holding the KeepHQ API key
+	envAPIKey = "KEEP_API_KEY"
+	// envAPIURL is the environment variable holding the KeepHQ API URL
+	envAPIURL = "KEEP_API_URL"
+	// envConfigFile is the environment variable overriding the profile file location
+	envConfigFile = "KEEP_CONFIG_FILE"
+	// envProfile is the environment variable selecting the profile to read
+	envProfile = "KEEP_PROFILE"
+	// envAuthType is the environment variable selecting the authentication method
+	envAuthType = "KEEP_AUTH_TYPE"
+	// envBearerToken is the environment variable holding a static bearer token
+	envBearerToken = "KEEP_BEARER_TOKEN"
+	// envUsername is the environment variable holding the basic auth username
+	envUsername = "KEEP_USERNAME"
+	// envPassword is the environment variable holding the basic auth password
+	envPassword = "KEEP_PASSWORD"
+	// envTokenURL is the environment variable holding the OAuth2 token endpoint
+	envTokenURL = "KEEP_OAUTH2_TOKEN_URL"
+	// envClientID is the environment variable holding the OAuth2 client ID
+	envClientID = "KEEP_OAUTH2_CLIENT_ID"
+	// envClientSecret is the environment variable holding the OAuth2 client secret
+	envClientSecret = "KEEP_OAUTH2_CLIENT_SECRET"
+	// envScopes is the environment variable holding space-separated OAuth2 scopes
+	envScopes = "KEEP_OAUTH2_SCOPES"
+	// envAudience is the environment variable holding the OAuth2 audience
+	envAudience = "KEEP_OAUTH2_AUDIENCE"
+	// envCACertFile is the environment variable holding the path of a CA bundle
+	envCACertFile = "KEEP_CA_CERT_FILE"
+	// envClientCert is the environment variable holding the mTLS client certificate (PEM or path)
+	envClientCert = "KEEP_CLIENT_CERT"
+	// envClientKey is the environment variable holding the mTLS client key (PEM or path)
+	envClientKey = "KEEP_CLIENT_KEY"
+	// envInsecureSkipVerify is the environment variable disabling certificate verification
+	envInsecureSkipVerify = "KEEP_INSECURE_SKIP_VERIFY"
+	// envProxyURL is the environment variable holding an explicit proxy URL
+	envProxyURL = "KEEP_PROXY_URL"
+
+	// defaultProfile is the profile read when none is configured
+	defaultProfile = "default"
+)
+
+// Supported values of the auth_type attribute
+const (
+	authTypeAPIKey                  = "api_key"
+	authTypeBearer                  = "bearer"
+	authTypeOAuth2ClientCredentials = "oauth2_client_credentials"
+	authTypeBasic                   = "basic"
+)
+
+// authTypes lists the supported authentication methods
+var authTypes = []string{authTypeAPIKey, authTypeBearer, authTypeOAuth2ClientCredentials, authTypeBasic}
+
+// errProfileNotFound is returned by loadProfile when the file lacks the requested profile
+var errProfileNotFound = errors.New("profile not found")
+
+// Sources a resolved setting can come from, used in logs and diagnostics.
+const (
+	sourceConfig  = "provider configuration"
+	sourceEnv     = "environment"
+	sourceProfile = "profile"
+	sourceDefault = "default"
+)
+
+// resolvedProviderConfig holds the provider settings after merging, in order of
+// precedence, the HCL configuration, environment variables and a profile file.
+type resolvedProviderConfig struct {
+	APIKey       string
+	APIKeySource string
+	APIURL       string
+	APIURLSource string
+	AuthType     string
+	// Auth is the authenticator for AuthType, built from the resolved credentials
+	Auth keep.Authenticator
+	// TLS holds custom TLS settings, nil when the defaults apply
+	TLS *keep.TLSConfig
+	// ProxyURL is an explicit proxy, empty to use the proxy environment variables
+	ProxyURL string
+	// ProfileFile is the profile file that was read, if any
+	ProfileFile string
+	// Profile is the profile name that was selected
+	Profile string
+}
+
+// defaultConfigFile returns the default location of the profile file, ~/.keep/credentials.
+func defaultConfigFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".keep", "credentials")
+}
+
+// resolveProviderConfig merges the provider configuration with the environment
+// (read through getenv) and the selected profile, and validates the result.
+func resolveProviderConfig(config providerModel, getenv func(string) string) (resolvedProviderConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	resolved := resolvedProviderConfig{}
+
+	// Locate the profile file; a missing file is only an error when it was requested explicitly
+	configFile, explicitFile := firstSet(config.ConfigFile, getenv(envConfigFile))
+	if configFile == "" {
+		configFile = defaultConfigFile()
+	}
+	profile, explicitProfile := firstSet(config.Profile, getenv(envProfile))
+	if profile == "" {
+		profile = defaultProfile
+	}
+	resolved.Profile = profile
+
+	profileValues, err := loadProfile(configFile, profile)
+	switch {
+	case err == nil:
+		resolved.ProfileFile = configFile
+	case (os.IsNotExist(err) || errors.Is(err, errProfileNotFound)) && !explicitFile && !explicitProfile:
+		// No profile file and none requested: nothing to merge
+	default:
+		attrPath := path.Root("profile")
+		if explicitFile {
+			attrPath = path.Root("config_file")
+		}
+		diags.AddAttributeError(
+			attrPath,
+			"Unable to Read KeepHQ Profile",
+			fmt.Sprintf("Could not read profile %q from %s: %s", profile, configFile, err),
+		)
+		return resolved, diags
+	}
+
+	// Every setting resolves as HCL > environment variable > profile entry
+	lookup := func(configured types.String, env, key string) (string, string) {
+		switch {
+		case !configured.IsNull() && !configured.IsUnknown() && configured.ValueString() != "":
+			return configured.ValueString(), sourceConfig
+		case getenv(env) != "":
+			return getenv(env), sourceEnv
+		case profileValues[key] != "":
+			return profileValues[key], sourceProfile
+		}
+		return "", ""
+	}
+
+	resolved.APIKey, resolved.APIKeySource = lookup(config.APIKey, envAPIKey, "api_key")
+
+	rawURL, urlSource := lookup(config.APIURL, envAPIURL, "api_url")
+	if rawURL == "" {
+		rawURL, urlSource = keep.DefaultBaseURL, sourceDefault
+	}
+
+	apiURL, err := normalizeAPIURL(rawURL)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("api_url"),
+			"Invalid KeepHQ API URL",
+			fmt.Sprintf("The KeepHQ API URL %q from the %s is invalid: %s", rawURL, urlSource, err),
+		)
+	}
+	resolved.APIURL, resolved.APIURLSource = apiURL, urlSource
+
+	resolved.AuthType, _ = lookup(config.AuthType, envAuthType, "auth_type")
+	if resolved.AuthType == "" {
+		resolved.AuthType = authTypeAPIKey
+	}
+
+	// require reports a missing credential for the selected authentication method
+	require := func(value, attr, env string) bool {
+		if value != "" {
+			return true
+		}
+		diags.AddAttributeError(
+			path.Root(attr),
+			"Missing KeepHQ Credentials",
+			fmt.Sprintf("The provider cannot create the KeepHQ API client because auth_type %q requires %s, which was not found. ", resolved.AuthType, attr)+
+				fmt.Sprintf("Set the %s attribute in the provider configuration, set the %s environment variable, ", attr, env)+
+				fmt.Sprintf("or add a %s entry to profile %q in %s.", attr, profile, configFile),
+		)
+		return false
+	}
+
+	switch resolved.AuthType {
+	case authTypeAPIKey:
+		if resolved.APIKey == "" {
+			diags.AddAttributeError(
+				path.Root("api_key"),
+				"Missing KeepHQ API Key",
+				"The provider cannot create the KeepHQ API client because no API key was found. "+
+					"Set the api_key attribute in the provider configuration, set the "+envAPIKey+" environment variable, "+
+					fmt.Sprintf("or add an api_key entry to profile %q in %s.", profile, configFile),
+			)
+			break
+		}
+		resolved.Auth = &keep.APIKeyAuth{APIKey: resolved.APIKey}
+
+	case authTypeBearer:
+		token, _ := lookup(config.BearerToken, envBearerToken, "bearer_token")
+		if require(token, "bearer_token", envBearerToken) {
+			resolved.Auth = &keep.BearerTokenAuth{Token: token}
+		}
+
+	case authTypeBasic:
+		username, _ := lookup(config.Username, envUsername, "username")
+		password, _ := lookup(config.Password, envPassword, "password")
+		if require(username, "username", envUsername) && require(password, "password", envPassword) {
+			resolved.Auth = &keep.BasicAuth{Username: username, Password: password}
+		}
+
+	case authTypeOAuth2ClientCredentials:
+		tokenURL, _ := lookup(config.TokenURL, envTokenURL, "token_url")
+		clientID, _ := lookup(config.ClientID, envClientID, "client_id")
+		clientSecret, _ := lookup(config.ClientSecret, envClientSecret, "client_secret")
+		audience, _ := lookup(config.Audience, envAudience, "audience")
+
+		var scopes []string
+		if !config.Scopes.IsNull() && !config.Scopes.IsUnknown() {
+			for _, v := range config.Scopes.Elements() {
+				if sv, ok := v.(types.String); ok {
+					scopes = append(scopes, sv.ValueString())
+				}
+			}
+		} else if raw, _ := lookup(types.StringNull(), envScopes, "scopes"); raw != "" {
+			scopes = strings.FieldsFunc(raw, func(r rune) bool { return r == ' ' || r == ',' })
+		}
+
+		ok := require(tokenURL, "token_url", envTokenURL)
+		ok = require(clientID, "client_id", envClientID) && ok
+		ok = require(clientSecret, "client_secret", envClientSecret) && ok
+		if tokenURL != "" {
+			if _, err := normalizeAPIURL(tokenURL); err != nil {
+				diags.AddAttributeError(
+					path.Root("token_url"),
+					"Invalid OAuth2 Token URL",
+					fmt.Sprintf("The OAuth2 token URL %q is invalid: %s", tokenURL, err),
+				)
+				ok = false
+			}
+		}
+		if ok {
+			resolved.Auth = &keep.OAuth2ClientCredentialsAuth{
+				TokenURL:     tokenURL,
+				ClientID:     clientID,
+				ClientSecret: clientSecret,
+				Scopes:       scopes,
+				Audience:     audience,
+			}
+		}
+
+	default:
+		diags.AddAttributeError(
+			path.Root("auth_type"),
+			"Invalid KeepHQ Authentication Type",
+			fmt.Sprintf("auth_type must be one of %s, got: %q", strings.Join(authTypes, ", "), resolved.AuthType),
+		)
+	}
+
+	// TLS: CA bundle, client certificate and verification
+	tlsCfg := &keep.TLSConfig{}
+	customTLS := false
+
+	caCertFile, _ := lookup(config.CACertFile, envCACertFile, "ca_cert_file")
+	switch {
+	case !config.CACertPEM.IsNull() && !config.CACertPEM.IsUnknown() && config.CACertPEM.ValueString() != "":
+		tlsCfg.CACertPEM = []byte(config.CACertPEM.ValueString())
+		customTLS = true
+	case caCertFile != "":
+		pem, err := os.ReadFile(caCertFile)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Unable to Read CA Certificate",
+				fmt.Sprintf("Could not read CA certificate file %s: %s", caCertFile, err),
+			)
+		}
+		tlsCfg.CACertPEM = pem
+		customTLS = true
+	}
+
+	clientCert, _ := lookup(config.ClientCert, envClientCert, "client_cert")
+	clientKey, _ := lookup(config.ClientKey, envClientKey, "client_key")
+	if clientCert != "" || clientKey != "" {
+		if clientCert == "" || clientKey == "" {
+			diags.AddAttributeError(
+				path.Root("client_cert"),
+				"Incomplete Client Certificate",
+				"Both client_cert and client_key must be set to use a client certificate.",
+			)
+		} else {
+			var err error
+			if tlsCfg.ClientCertPEM, err = readPEMOrFile(clientCert); err != nil {
+				diags.AddAttributeError(path.Root("client_cert"), "Unable to Read Client Certificate", err.Error())
+			}
+			if tlsCfg.ClientKeyPEM, err = readPEMOrFile(clientKey); err != nil {
+				diags.AddAttributeError(path.Root("client_key"), "Unable to Read Client Key", err.Error())
+			}
+			customTLS = true
+		}
+	}
+
+	insecure := false
+	if !config.InsecureSkipVerify.IsNull() && !config.InsecureSkipVerify.IsUnknown() {
+		insecure = config.InsecureSkipVerify.ValueBool()
+	} else if raw, source := lookup(types.StringNull(), envInsecureSkipVerify, "insecure_skip_verify"); raw != "" {
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("insecure_skip_verify"),
+				"Invalid insecure_skip_verify Value",
+				fmt.Sprintf("The insecure_skip_verify value %q from the %s is not a boolean.", raw, source),
+			)
+		}
+		insecure = v
+	}
+	if insecure {
+		tlsCfg.InsecureSkipVerify = true
+		customTLS = true
+		diags.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS Certificate Verification Disabled",
+			"The provider will not verify the KeepHQ API server certificate. Do not use insecure_skip_verify outside of testing; configure ca_cert_file or ca_cert_pem for private CAs instead.",
+		)
+	}
+
+	if customTLS {
+		resolved.TLS = tlsCfg
+	}
+
+	resolved.ProxyURL, _ = lookup(config.ProxyURL, envProxyURL, "proxy_url")
+
+	return resolved, diags
+}
+
+// readPEMOrFile returns value itself when it holds PEM data, and otherwise reads it as a file path.
+func readPEMOrFile(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN") {
+		return []byte(value), nil
+	}
+	data, err := os.ReadFile(value)
+	if err != nil {
+		return nil, fmt.Errorf("could not read %s: %w", value, err)
+	}
+	return data, nil
+}
+
+// firstSet returns the configured value if set, otherwise the environment value,
+// along with whether either was set at all.
+func firstSet(configured types.String, env string) (string, bool) {
+	if !configured.IsNull() && !configured.IsUnknown() && configured.ValueString() != "" {
+		return configured.ValueString(), true
+	}
+	return env, env != ""
+}
+
+// normalizeAPIURL validates an API URL and strips trailing slashes so paths can be appended.
+func normalizeAPIURL(raw string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("scheme must be http or https")
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("host is missing")
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return "", fmt.Errorf("query strings and fragments are not supported")
+	}
+
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = ""
+	return u.String(), nil
+}
+
+// loadProfile reads a single profile from an INI-style profile file:
+//
+//	[default]
+//	api_key = ...
+//	api_url = https://keep.example.com
+//
+// Lines starting with '#' or ';' are comments.
+func loadProfile(filename, profile string) (map[string]string, error) {
+	if filename == "" {
+		return nil, os.ErrNotExist
+	}
+
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	values := make(map[string]string)
+	found := false
+	current := ""
+	scanner := bufio.NewScanner(f)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: malformed profile header %q", lineNo, line)
+			}
+			current = strings.TrimSpace(line[1 : len(line)-1])
+			if current == profile {
+				found = true
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNo)
+		}
+		if current == profile {
+			values[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"`)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, errProfileNotFound
+	}
+	return values, nil
+}
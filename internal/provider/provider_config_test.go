@@ -0,0 +1,335 @@
+// provider_config_test.go - Unit tests for provider configuration resolution
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+func testProviderModel() providerModel {
+	return providerModel{
+		APIKey:             types.StringNull(),
+		APIURL:             types.StringNull(),
+		ConfigFile:         types.StringNull(),
+		Profile:            types.StringNull(),
+		AuthType:           types.StringNull(),
+		BearerToken:        types.StringNull(),
+		Username:           types.StringNull(),
+		Password:           types.StringNull(),
+		TokenURL:           types.StringNull(),
+		ClientID:           types.StringNull(),
+		ClientSecret:       types.StringNull(),
+		Scopes:             types.ListNull(types.StringType),
+		Audience:           types.StringNull(),
+		CACertFile:         types.StringNull(),
+		CACertPEM:          types.StringNull(),
+		ClientCert:         types.StringNull(),
+		ClientKey:          types.StringNull(),
+		ProxyURL:           types.StringNull(),
+		InsecureSkipVerify: types.BoolNull(),
+	}
+}
+
+func writeProfileFile(t *testing.T, content string) string {
+	t.Helper()
+
+	filename := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(filename, []byte(content), 0o600); err != nil {
+		t.Fatalf("writing profile file: %s", err)
+	}
+	return filename
+}
+
+func TestResolveProviderConfigPrecedence(t *testing.T) {
+	// Keep the default ~/.keep/credentials out of the picture
+	t.Setenv("HOME", t.TempDir())
+
+	profileFile := writeProfileFile(t, `
+# shared credentials
+[default]
+api_key = profile-key
+api_url = https://profile.example.com/
+
+[staging]
+api_key = "staging-key"
+api_url = https://staging.example.com
+`)
+
+	tests := []struct {
+		name          string
+		config        func(m *providerModel)
+		env           map[string]string
+		wantKey       string
+		wantKeySource string
+		wantURL       string
+	}{
+		{
+			name:          "profile only",
+			env:           map[string]string{envConfigFile: profileFile},
+			wantKey:       "profile-key",
+			wantKeySource: sourceProfile,
+			wantURL:       "https://profile.example.com",
+		},
+		{
+			name:          "named profile",
+			env:           map[string]string{envConfigFile: profileFile, envProfile: "staging"},
+			wantKey:       "staging-key",
+			wantKeySource: sourceProfile,
+			wantURL:       "https://staging.example.com",
+		},
+		{
+			name: "environment overrides profile",
+			env: map[string]string{
+				envConfigFile: profileFile,
+				envAPIKey:     "env-key",
+				envAPIURL:     "http://env.example.com:8080//",
+			},
+			wantKey:       "env-key",
+			wantKeySource: sourceEnv,
+			wantURL:       "http://env.example.com:8080",
+		},
+		{
+			name: "configuration overrides environment",
+			config: func(m *providerModel) {
+				m.APIKey = types.StringValue("hcl-key")
+				m.APIURL = types.StringValue("https://hcl.example.com/keep/")
+			},
+			env: map[string]string{
+				envConfigFile: profileFile,
+				envAPIKey:     "env-key",
+				envAPIURL:     "http://env.example.com",
+			},
+			wantKey:       "hcl-key",
+			wantKeySource: sourceConfig,
+			wantURL:       "https://hcl.example.com/keep",
+		},
+		{
+			name:          "default URL",
+			env:           map[string]string{envAPIKey: "env-key"},
+			wantKey:       "env-key",
+			wantKeySource: sourceEnv,
+			wantURL:       "http://localhost:8080",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testProviderModel()
+			if tt.config != nil {
+				tt.config(&config)
+			}
+
+			resolved, diags := resolveProviderConfig(config, func(k string) string { return tt.env[k] })
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if resolved.APIKey != tt.wantKey || resolved.APIKeySource != tt.wantKeySource {
+				t.Errorf("api key = %q from %s, want %q from %s", resolved.APIKey, resolved.APIKeySource, tt.wantKey, tt.wantKeySource)
+			}
+			if resolved.APIURL != tt.wantURL {
+				t.Errorf("api url = %q, want %q", resolved.APIURL, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestResolveProviderConfigErrors(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	tests := []struct {
+		name    string
+		config  func(m *providerModel)
+		env     map[string]string
+		summary string
+	}{
+		{
+			name:    "missing api key",
+			env:     map[string]string{},
+			summary: "Missing KeepHQ API Key",
+		},
+		{
+			name:    "invalid url scheme",
+			env:     map[string]string{envAPIKey: "key", envAPIURL: "ftp://keep.example.com"},
+			summary: "Invalid KeepHQ API URL",
+		},
+		{
+			name:    "url without host",
+			env:     map[string]string{envAPIKey: "key", envAPIURL: "keep.example.com"},
+			summary: "Invalid KeepHQ API URL",
+		},
+		{
+			name: "explicit missing profile file",
+			config: func(m *providerModel) {
+				m.ConfigFile = types.StringValue(filepath.Join(t.TempDir(), "missing"))
+			},
+			env:     map[string]string{envAPIKey: "key"},
+			summary: "Unable to Read KeepHQ Profile",
+		},
+		{
+			name:    "unknown profile",
+			env:     map[string]string{envAPIKey: "key", envConfigFile: writeProfileFile(t, "[default]\napi_key = x\n"), envProfile: "prod"},
+			summary: "Unable to Read KeepHQ Profile",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := testProviderModel()
+			if tt.config != nil {
+				tt.config(&config)
+			}
+
+			_, diags := resolveProviderConfig(config, func(k string) string { return tt.env[k] })
+			if !diags.HasError() {
+				t.Fatal("expected an error diagnostic")
+			}
+			if got := diags.Errors()[0].Summary(); got != tt.summary {
+				t.Errorf("summary = %q, want %q", got, tt.summary)
+			}
+		})
+	}
+}
+
+func TestResolveProviderConfigAuth(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	tests := []struct {
+		name     string
+		env      map[string]string
+		wantAuth interface{}
+		summary  string
+	}{
+		{
+			name:     "bearer",
+			env:      map[string]string{envAuthType: authTypeBearer, envBearerToken: "jwt"},
+			wantAuth: &keep.BearerTokenAuth{},
+		},
+		{
+			name:     "basic",
+			env:      map[string]string{envAuthType: authTypeBasic, envUsername: "keep", envPassword: "secret"},
+			wantAuth: &keep.BasicAuth{},
+		},
+		{
+			name: "oauth2 client credentials",
+			env: map[string]string{
+				envAuthType:     authTypeOAuth2ClientCredentials,
+				envTokenURL:     "https://idp.example.com/token",
+				envClientID:     "terraform",
+				envClientSecret: "secret",
+				envScopes:       "keep:read keep:write",
+			},
+			wantAuth: &keep.OAuth2ClientCredentialsAuth{},
+		},
+		{
+			name:    "bearer without token",
+			env:     map[string]string{envAuthType: authTypeBearer},
+			summary: "Missing KeepHQ Credentials",
+		},
+		{
+			name:    "oauth2 with invalid token url",
+			env:     map[string]string{envAuthType: authTypeOAuth2ClientCredentials, envTokenURL: "idp/token", envClientID: "id", envClientSecret: "secret"},
+			summary: "Invalid OAuth2 Token URL",
+		},
+		{
+			name:    "unknown auth type",
+			env:     map[string]string{envAuthType: "kerberos"},
+			summary: "Invalid KeepHQ Authentication Type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved, diags := resolveProviderConfig(testProviderModel(), func(k string) string { return tt.env[k] })
+
+			if tt.summary != "" {
+				if !diags.HasError() {
+					t.Fatal("expected an error diagnostic")
+				}
+				if got := diags.Errors()[0].Summary(); got != tt.summary {
+					t.Errorf("summary = %q, want %q", got, tt.summary)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got, want := fmt.Sprintf("%T", resolved.Auth), fmt.Sprintf("%T", tt.wantAuth); got != want {
+				t.Errorf("authenticator = %s, want %s", got, want)
+			}
+			if oauth, ok := resolved.Auth.(*keep.OAuth2ClientCredentialsAuth); ok && len(oauth.Scopes) != 2 {
+				t.Errorf("expected 2 scopes, got %v", oauth.Scopes)
+			}
+		})
+	}
+}
+
+func TestResolveProviderConfigTLS(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte("-----BEGIN CERTIFICATE-----\n"), 0o600); err != nil {
+		t.Fatalf("writing CA file: %s", err)
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		resolved, diags := resolveProviderConfig(testProviderModel(), func(k string) string {
+			return map[string]string{envAPIKey: "key"}[k]
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if resolved.TLS != nil || resolved.ProxyURL != "" {
+			t.Errorf("expected default transport settings, got TLS %+v and proxy %q", resolved.TLS, resolved.ProxyURL)
+		}
+	})
+
+	t.Run("environment", func(t *testing.T) {
+		env := map[string]string{
+			envAPIKey:             "key",
+			envCACertFile:         caFile,
+			envInsecureSkipVerify: "true",
+			envProxyURL:           "http://proxy.example.com:3128",
+		}
+		resolved, diags := resolveProviderConfig(testProviderModel(), func(k string) string { return env[k] })
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if len(diags.Warnings()) != 1 {
+			t.Errorf("expected an insecure_skip_verify warning, got %v", diags)
+		}
+		if resolved.TLS == nil || len(resolved.TLS.CACertPEM) == 0 || !resolved.TLS.InsecureSkipVerify {
+			t.Errorf("unexpected TLS settings: %+v", resolved.TLS)
+		}
+		if resolved.ProxyURL != "http://proxy.example.com:3128" {
+			t.Errorf("proxy url = %q", resolved.ProxyURL)
+		}
+	})
+
+	t.Run("client certificate without key", func(t *testing.T) {
+		config := testProviderModel()
+		config.ClientCert = types.StringValue("-----BEGIN CERTIFICATE-----\n")
+		_, diags := resolveProviderConfig(config, func(k string) string {
+			return map[string]string{envAPIKey: "key"}[k]
+		})
+		if !diags.HasError() || diags.Errors()[0].Summary() != "Incomplete Client Certificate" {
+			t.Errorf("expected an incomplete client certificate error, got %v", diags)
+		}
+	})
+
+	t.Run("missing CA file", func(t *testing.T) {
+		config := testProviderModel()
+		config.CACertFile = types.StringValue(filepath.Join(t.TempDir(), "missing.pem"))
+		_, diags := resolveProviderConfig(config, func(k string) string {
+			return map[string]string{envAPIKey: "key"}[k]
+		})
+		if !diags.HasError() || diags.Errors()[0].Summary() != "Unable to Read CA Certificate" {
+			t.Errorf("expected a CA certificate error, got %v", diags)
+		}
+	})
+}
@@ -2,6 +2,7 @@
 package provider
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -24,3 +25,18 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("KEEP_API_KEY must be set for acceptance tests")
 	}
 }
+
+func TestProviderSchema(t *testing.T) {
+	server, err := testAccProtoV6ProviderFactories["keep"]()
+	if err != nil {
+		t.Fatalf("creating provider server: %s", err)
+	}
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s: %s", d.Severity, d.Summary, d.Detail)
+	}
+}
@@ -5,16 +5,17 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/keephq/terraform-provider-keep/internal/client"
+	"github.com/keephq/terraform-provider-keep/keep"
 )
 
 type AlertResource struct {
-	client *client.Client
+	client *keep.Client
 }
 
 type AlertResourceModel struct {
@@ -32,10 +33,11 @@ type AlertResourceModel struct {
 	Labels      types.Map    `tfsdk:"labels"`
 	Fingerprint types.String `tfsdk:"fingerprint"`
 	LastReceived types.String `tfsdk:"last_received"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
-// toClientAlert converts the Terraform model to a client.Alert
-func (m *AlertResourceModel) toClientAlert(ctx context.Context) (*client.Alert, diag.Diagnostics) {
+// toClientAlert converts the Terraform model to a keep.Alert
+func (m *AlertResourceModel) toClientAlert(ctx context.Context) (*keep.Alert, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// Convert source list to []string
@@ -70,69 +72,81 @@ func (m *AlertResourceModel) toClientAlert(ctx context.Context) (*client.Alert,
 		lastReceived = time.Now().UTC().Format(time.RFC3339)
 	}
 
-	return &client.Alert{
+	return &keep.Alert{
 		ID:           m.ID.ValueString(),
 		Fingerprint:  m.Fingerprint.ValueString(),
 		Name:         m.Name.ValueString(),
 		Status:       status,
 		Severity:     severity,
 		Environment:  m.Environment.ValueString(),
-		Service:      m.Service.ValueString(),
+		Service:      m.Service.ValueStringPointer(),
 		Source:       sources,
-		Message:      m.Message.ValueString(),
-		Description:  m.Description.ValueString(),
-		URL:          m.URL.ValueString(),
-		ImageURL:     m.ImageURL.ValueString(),
+		Message:      m.Message.ValueStringPointer(),
+		Description:  m.Description.ValueStringPointer(),
+		URL:          m.URL.ValueStringPointer(),
+		ImageURL:     m.ImageURL.ValueStringPointer(),
 		Labels:       labels,
 		LastReceived: lastReceived,
 	}, diags
 }
 
-// fromClientAlert converts a client.Alert to the Terraform model
-func (m *AlertResourceModel) fromClientAlert(ctx context.Context, alert map[string]interface{}) diag.Diagnostics {
+// fromClientAlert converts a keep.Alert to the Terraform model
+func (m *AlertResourceModel) fromClientAlert(ctx context.Context, alert *keep.Alert) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	// Set basic fields
-	m.ID = types.StringValue(alert["id"].(string))
-	m.Fingerprint = types.StringValue(alert["fingerprint"].(string))
-	m.Name = types.StringValue(alert["name"].(string))
-	m.Status = types.StringValue(alert["status"].(string))
-	m.Severity = types.StringValue(alert["severity"].(string))
-	m.Environment = types.StringValue(alert["environment"].(string))
-	m.Service = types.StringValue(alert["service"].(string))
-	m.Message = types.StringValue(alert["message"].(string))
-	m.Description = types.StringValue(alert["description"].(string))
-	m.URL = types.StringValue(alert["url"].(string))
-	m.ImageURL = types.StringValue(alert["image_url"].(string))
-	m.LastReceived = types.StringValue(alert["lastReceived"].(string))
-
-	// Convert source slice to List
-	sources := make([]string, 0)
-	if src, ok := alert["source"].([]interface{}); ok {
-		for _, s := range src {
-			sources = append(sources, s.(string))
-		}
+	m.ID = types.StringValue(alert.ID)
+	m.Fingerprint = types.StringValue(alert.Fingerprint)
+	m.Name = types.StringValue(alert.Name)
+	m.Status = types.StringValue(alert.Status)
+	m.Severity = types.StringValue(alert.Severity)
+	m.Environment = types.StringValue(alert.Environment)
+	m.Service = optionalStringPointer(alert.Service)
+	m.Message = optionalStringPointer(alert.Message)
+	m.Description = optionalStringPointer(alert.Description)
+	m.URL = optionalStringPointer(alert.URL)
+	m.ImageURL = optionalStringPointer(alert.ImageURL)
+	m.LastReceived = types.StringValue(alert.LastReceived)
+
+	// No sources or labels match unset source and labels attributes
+	if len(alert.Source) > 0 || !m.Source.IsNull() {
+		sourceList, d := types.ListValueFrom(ctx, types.StringType, alert.Source)
+		diags.Append(d...)
+		m.Source = sourceList
 	}
-	sourceList, d := types.ListValueFrom(ctx, types.StringType, sources)
-	diags.Append(d...)
-	m.Source = sourceList
 
-	// Convert labels map to Map
-	labels := make(map[string]string)
-	if l, ok := alert["labels"].(map[string]interface{}); ok {
-		for k, v := range l {
-			if s, ok := v.(string); ok {
-				labels[k] = s
-			}
-		}
+	if len(alert.Labels) > 0 || !m.Labels.IsNull() {
+		labelMap, d := types.MapValueFrom(ctx, types.StringType, map[string]string(alert.Labels))
+		diags.Append(d...)
+		m.Labels = labelMap
 	}
-	labelMap, d := types.MapValueFrom(ctx, types.StringType, labels)
-	diags.Append(d...)
-	m.Labels = labelMap
 
 	return diags
 }
 
+// setCreatedAlert sets the computed attributes after a create from the alert
+// returned by Keep. Values the response leaves out fall back to the ones that
+// were sent, so that no attribute stays unknown after apply.
+func (m *AlertResourceModel) setCreatedAlert(sent, result *keep.Alert) {
+	fingerprint := firstNonEmpty(result.Fingerprint, sent.Fingerprint)
+	m.ID = types.StringValue(firstNonEmpty(result.ID, fingerprint))
+	m.Fingerprint = types.StringValue(fingerprint)
+	m.Status = types.StringValue(firstNonEmpty(result.Status, sent.Status))
+	m.Severity = types.StringValue(firstNonEmpty(result.Severity, sent.Severity))
+	m.Environment = types.StringValue(firstNonEmpty(result.Environment, sent.Environment))
+	m.LastReceived = types.StringValue(firstNonEmpty(result.LastReceived, sent.LastReceived))
+}
+
+// firstNonEmpty returns the first of values that is not empty.
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
 func NewAlertResource() resource.Resource {
 	return &AlertResource{}
 }
@@ -210,6 +224,9 @@ func (r *AlertResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				MarkdownDescription: "The timestamp when the alert was last received",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -218,11 +235,11 @@ func (r *AlertResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(*keep.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -239,6 +256,15 @@ func (r *AlertResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Convert to client alert
 	alert, diags := data.toClientAlert(ctx)
 	resp.Diagnostics.Append(diags...)
@@ -253,14 +279,7 @@ func (r *AlertResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Set the ID and fingerprint from the response
-	if id, ok := result["id"].(string); ok {
-		data.ID = types.StringValue(id)
-	}
-
-	if fingerprint, ok := result["fingerprint"].(string); ok {
-		data.Fingerprint = types.StringValue(fingerprint)
-	}
+	data.setCreatedAlert(alert, result)
 
 	// Set the state with the populated data
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -282,15 +301,28 @@ func (r *AlertResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Call the API to get the alert
 	alert, err := r.client.GetAlert(ctx, fingerprint)
 	if err != nil {
+		if keep.IsNotFound(err) {
+			removeDeletedResource(ctx, resp, "alert", fingerprint)
+			return
+		}
 		resp.Diagnostics.AddError("Error reading alert", err.Error())
 		return
 	}
 
 	// Update the model with the response data
-	diags := data.fromClientAlert(ctx, alert)
+	diags = data.fromClientAlert(ctx, alert)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -309,6 +341,15 @@ func (r *AlertResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Convert to client alert
 	alert, diags := data.toClientAlert(ctx)
 	resp.Diagnostics.Append(diags...)
@@ -317,17 +358,17 @@ func (r *AlertResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Prepare the enrich data
-	enrichData := map[string]interface{}{
-		"fingerprint": alert.Fingerprint,
-		"status":      alert.Status,
-		"severity":    alert.Severity,
-		"message":     alert.Message,
-		"description": alert.Description,
-		"labels":      alert.Labels,
+	enrichment := keep.AlertEnrichment{
+		Fingerprint: alert.Fingerprint,
+		Status:      alert.Status,
+		Severity:    alert.Severity,
+		Message:     data.Message.ValueString(),
+		Description: data.Description.ValueString(),
+		Labels:      alert.Labels,
 	}
 
 	// Call the API to update the alert
-	_, err := r.client.EnrichAlert(ctx, alert.Fingerprint, enrichData)
+	err := r.client.EnrichAlert(ctx, enrichment)
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating alert", err.Error())
 		return
@@ -354,6 +395,15 @@ func (r *AlertResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Call the API to delete the alert
 	err := r.client.DeleteAlert(ctx, fingerprint)
 	if err != nil {
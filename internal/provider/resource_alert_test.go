@@ -1,13 +1,22 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"regexp"
 	"testing"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keephq/terraform-provider-keep/keep"
 )
 
 func TestAccAlertResource(t *testing.T) {
@@ -188,3 +197,83 @@ resource "keep_alert" "test" {
 		})
 	}
 }
+
+func TestAlertFromClientAlertMissingFields(t *testing.T) {
+	// Keep omits or nulls the fields an alert was sent without
+	var alert keep.Alert
+	body := `{"id": "a1", "fingerprint": "fp", "name": "disk full", "status": "firing", "service": null, "labels": {"region": "eu", "replicas": 3}}`
+	if err := json.Unmarshal([]byte(body), &alert); err != nil {
+		t.Fatal(err)
+	}
+
+	m := AlertResourceModel{
+		Source: types.ListNull(types.StringType),
+		Labels: types.MapNull(types.StringType),
+	}
+	if diags := m.fromClientAlert(context.Background(), &alert); diags.HasError() {
+		t.Fatalf("fromClientAlert: %v", diags)
+	}
+
+	if m.Fingerprint.ValueString() != "fp" || m.Name.ValueString() != "disk full" {
+		t.Errorf("unexpected alert fields: %+v", m)
+	}
+	for name, value := range map[string]types.String{"service": m.Service, "message": m.Message, "url": m.URL} {
+		if !value.IsNull() {
+			t.Errorf("expected null %s, got %s", name, value)
+		}
+	}
+	if !m.Source.IsNull() {
+		t.Errorf("expected null source, got %s", m.Source)
+	}
+	if got := m.Labels.Elements()["replicas"]; !got.Equal(types.StringValue("3")) {
+		t.Errorf("expected label replicas=3, got %v", m.Labels)
+	}
+}
+
+func TestAlertCreateSetsComputedAttributes(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Keep answers with the fingerprint only
+		fmt.Fprint(w, `{"fingerprint": "fp-1"}`)
+	}))
+	defer server.Close()
+
+	c, err := keep.NewClient(server.URL, "test-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &AlertResource{client: c}
+
+	var schemaResp fwresource.SchemaResponse
+	r.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	for _, name := range []string{"id", "status", "severity", "environment", "fingerprint", "last_received"} {
+		values[name] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	}
+	values["name"] = tftypes.NewValue(tftypes.String, "disk full")
+	values["severity"] = tftypes.NewValue(tftypes.String, "warning")
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+
+	resp := fwresource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsFullyKnown() {
+		t.Errorf("expected a fully known state, got %s", resp.State.Raw)
+	}
+
+	var state AlertResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.ID.ValueString() != "fp-1" || state.Fingerprint.ValueString() != "fp-1" {
+		t.Errorf("expected id and fingerprint fp-1, got %s and %s", state.ID, state.Fingerprint)
+	}
+	if state.Status.ValueString() != "firing" || state.Severity.ValueString() != "warning" || state.LastReceived.ValueString() == "" {
+		t.Errorf("expected the sent status, severity and last_received, got %s, %s and %s", state.Status, state.Severity, state.LastReceived)
+	}
+}
@@ -0,0 +1,394 @@
+// resource_correlation_rule.go - Resource implementation for KeepHQ correlation rules
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &correlationRuleResource{}
+	_ resource.ResourceWithConfigure   = &correlationRuleResource{}
+	_ resource.ResourceWithImportState = &correlationRuleResource{}
+)
+
+// NewCorrelationRuleResource is a helper function to simplify the provider implementation.
+func NewCorrelationRuleResource() resource.Resource {
+	return &correlationRuleResource{}
+}
+
+// correlationRuleResource defines the resource implementation.
+type correlationRuleResource struct {
+	client *keep.Client
+}
+
+// correlationRuleResourceModel maps the resource schema data.
+type correlationRuleResourceModel struct {
+	ID               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	CELQuery         celExpression  `tfsdk:"cel_query"`
+	Timeframe        types.Int64    `tfsdk:"timeframe"`
+	TimeUnit         types.String   `tfsdk:"time_unit"`
+	GroupingCriteria types.List     `tfsdk:"grouping_criteria"`
+	RequireApprove   types.Bool     `tfsdk:"require_approve"`
+	ResolveOn        types.String   `tfsdk:"resolve_on"`
+	CreateOn         types.String   `tfsdk:"create_on"`
+	CreatedBy        types.String   `tfsdk:"created_by"`
+	CreationTime     types.String   `tfsdk:"creation_time"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+// toClientRequest converts the Terraform model to a keep.CorrelationRuleRequest
+func (m *correlationRuleResourceModel) toClientRequest(ctx context.Context) (keep.CorrelationRuleRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	groupingCriteria := []string{}
+	if !m.GroupingCriteria.IsNull() {
+		diags.Append(m.GroupingCriteria.ElementsAs(ctx, &groupingCriteria, false)...)
+	}
+
+	return keep.CorrelationRuleRequest{
+		RuleName:           m.Name.ValueString(),
+		CELQuery:           m.CELQuery.ValueString(),
+		TimeframeInSeconds: m.Timeframe.ValueInt64(),
+		TimeUnit:           m.TimeUnit.ValueString(),
+		GroupingCriteria:   groupingCriteria,
+		RequireApprove:     m.RequireApprove.ValueBool(),
+		ResolveOn:          m.ResolveOn.ValueString(),
+		CreateOn:           m.CreateOn.ValueString(),
+	}, diags
+}
+
+// fromClientRule sets the model from a correlation rule returned by the API
+func (m *correlationRuleResourceModel) fromClientRule(ctx context.Context, rule *keep.CorrelationRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(rule.ID)
+	m.Name = types.StringValue(rule.Name)
+	m.CELQuery = newCELExpressionValue(rule.DefinitionCEL)
+	m.Timeframe = types.Int64Value(rule.Timeframe)
+	if rule.TimeUnit != "" {
+		m.TimeUnit = types.StringValue(rule.TimeUnit)
+	}
+	m.RequireApprove = types.BoolValue(rule.RequireApprove)
+	if rule.ResolveOn != "" {
+		m.ResolveOn = types.StringValue(rule.ResolveOn)
+	}
+	if rule.CreateOn != "" {
+		m.CreateOn = types.StringValue(rule.CreateOn)
+	}
+	m.CreatedBy = optionalString(rule.CreatedBy)
+	m.CreationTime = optionalString(rule.CreationTime)
+
+	// No grouping matches an unset grouping_criteria
+	if len(rule.GroupingCriteria) > 0 || !m.GroupingCriteria.IsNull() {
+		var d diag.Diagnostics
+		m.GroupingCriteria, d = types.ListValueFrom(ctx, types.StringType, rule.GroupingCriteria)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// Metadata returns the resource type name.
+func (r *correlationRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_correlation_rule"
+}
+
+// Schema defines the schema for the resource.
+func (r *correlationRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an alert-to-incident correlation rule in Keep. Alerts matching the rule within the timeframe are grouped into an incident.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the correlation rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the correlation rule.",
+				Required:    true,
+			},
+			"cel_query": schema.StringAttribute{
+				Description: "CEL expression selecting the alerts to correlate, evaluated against the alert fields. It is parsed when the configuration is validated.",
+				Required:    true,
+				CustomType:  celExpressionType{},
+			},
+			"timeframe": schema.Int64Attribute{
+				Description: "The time window in seconds within which matching alerts are grouped into the same incident.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"time_unit": schema.StringAttribute{
+				Description: "The unit the timeframe is displayed in by the Keep UI: seconds, minutes, hours or days. Defaults to seconds.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("seconds"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("seconds", "minutes", "hours", "days"),
+				},
+			},
+			"grouping_criteria": schema.ListAttribute{
+				Description: "Alert fields whose values split matching alerts into separate incidents, such as labels.service.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"require_approve": schema.BoolAttribute{
+				Description: "Whether incidents created by the rule must be approved manually. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"resolve_on": schema.StringAttribute{
+				Description: "When incidents created by the rule are resolved: first, last or all alerts resolved, or never. Defaults to never.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("never"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("first", "last", "all", "never"),
+				},
+			},
+			"create_on": schema.StringAttribute{
+				Description: "Whether an incident is created when any alert matches, or only once all alerts of the rule matched. One of any or all. Defaults to any.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("any"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("any", "all"),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Description: "Who created the correlation rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creation_time": schema.StringAttribute{
+				Description: "When the correlation rule was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *correlationRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *correlationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan correlationRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	ruleReq, diags := plan.toClientRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the correlation rule via API
+	rule, err := r.client.CreateCorrelationRule(ctx, ruleReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating correlation rule",
+			"Could not create correlation rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Created correlation rule", map[string]interface{}{
+		"id": rule.ID,
+	})
+
+	// Map response back to the plan
+	resp.Diagnostics.Append(plan.fromClientRule(ctx, rule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *correlationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state correlationRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	ruleID := state.ID.ValueString()
+	rule, err := r.client.GetCorrelationRule(ctx, ruleID)
+	if err != nil {
+		if keep.IsNotFound(err) {
+			removeDeletedResource(ctx, resp, "correlation rule", ruleID)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading correlation rule",
+			"Could not read correlation rule ID "+ruleID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Every attribute is refreshed, so changes made in the Keep UI show up as drift
+	resp.Diagnostics.Append(state.fromClientRule(ctx, rule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *correlationRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan correlationRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	ruleReq, diags := plan.toClientRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the correlation rule via API
+	rule, err := r.client.UpdateCorrelationRule(ctx, plan.ID.ValueString(), ruleReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating correlation rule",
+			"Could not update correlation rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response back to the plan
+	resp.Diagnostics.Append(plan.fromClientRule(ctx, rule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *correlationRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state correlationRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete correlation rule via API
+	err := r.client.DeleteCorrelationRule(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting correlation rule",
+			"Could not delete correlation rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *correlationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
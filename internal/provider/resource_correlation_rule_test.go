@@ -0,0 +1,116 @@
+// resource_correlation_rule_test.go - Acceptance tests for the correlation_rule resource
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccCorrelationRuleResource(t *testing.T) {
+	// Skip if running short tests
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	resourceName := "keep_correlation_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid CEL is rejected at plan time
+			{
+				Config:      testAccCorrelationRuleResourceConfig(`severity ==`, 600, "never"),
+				ExpectError: regexp.MustCompile(`Invalid CEL Expression`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccCorrelationRuleResourceConfig(`source == "prometheus"`, 600, "never"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCorrelationRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-correlation-rule"),
+					resource.TestCheckResourceAttr(resourceName, "cel_query", `source == "prometheus"`),
+					resource.TestCheckResourceAttr(resourceName, "timeframe", "600"),
+					resource.TestCheckResourceAttr(resourceName, "time_unit", "minutes"),
+					resource.TestCheckResourceAttr(resourceName, "grouping_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "resolve_on", "never"),
+					resource.TestCheckResourceAttr(resourceName, "create_on", "any"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Timeouts only live in configuration
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccCorrelationRuleResourceConfig(`source == "prometheus" && severity == "critical"`, 1200, "all"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cel_query", `source == "prometheus" && severity == "critical"`),
+					resource.TestCheckResourceAttr(resourceName, "timeframe", "1200"),
+					resource.TestCheckResourceAttr(resourceName, "resolve_on", "all"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckCorrelationRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		client, err := getTestClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GetCorrelationRule(context.Background(), rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccCorrelationRuleResourceConfig(celQuery string, timeframe int, resolveOn string) string {
+	apiURL := os.Getenv("KEEP_API_URL")
+	if apiURL == "" {
+		apiURL = "http://localhost:8080"
+	}
+
+	return fmt.Sprintf(`
+provider "keep" {
+  api_key = %q
+  api_url = %q
+}
+
+resource "keep_correlation_rule" "test" {
+  name              = "tf-acc-correlation-rule"
+  cel_query         = %q
+  timeframe         = %d
+  time_unit         = "minutes"
+  grouping_criteria = ["labels.service"]
+  resolve_on        = %q
+}
+`, os.Getenv("KEEP_API_KEY"), apiURL, celQuery, timeframe, resolveOn)
+}
@@ -0,0 +1,424 @@
+// resource_deduplication_rule.go - Resource implementation for KeepHQ deduplication rules
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &deduplicationRuleResource{}
+	_ resource.ResourceWithConfigure   = &deduplicationRuleResource{}
+	_ resource.ResourceWithImportState = &deduplicationRuleResource{}
+	_ resource.ResourceWithModifyPlan  = &deduplicationRuleResource{}
+)
+
+// NewDeduplicationRuleResource is a helper function to simplify the provider implementation.
+func NewDeduplicationRuleResource() resource.Resource {
+	return &deduplicationRuleResource{}
+}
+
+// deduplicationRuleResource defines the resource implementation.
+type deduplicationRuleResource struct {
+	client *keep.Client
+}
+
+// deduplicationRuleResourceModel maps the resource schema data.
+type deduplicationRuleResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Description       types.String   `tfsdk:"description"`
+	ProviderID        types.String   `tfsdk:"provider_id"`
+	ProviderType      types.String   `tfsdk:"provider_type"`
+	FingerprintFields types.List     `tfsdk:"fingerprint_fields"`
+	FullDeduplication types.Bool     `tfsdk:"full_deduplication"`
+	IgnoreFields      types.List     `tfsdk:"ignore_fields"`
+	CreatedBy         types.String   `tfsdk:"created_by"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// toClientRequest converts the Terraform model to a keep.DeduplicationRuleRequest
+func (m *deduplicationRuleResourceModel) toClientRequest(ctx context.Context) (keep.DeduplicationRuleRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fingerprintFields := []string{}
+	diags.Append(m.FingerprintFields.ElementsAs(ctx, &fingerprintFields, false)...)
+
+	ignoreFields := []string{}
+	if !m.IgnoreFields.IsNull() {
+		diags.Append(m.IgnoreFields.ElementsAs(ctx, &ignoreFields, false)...)
+	}
+
+	var providerID *string
+	if !m.ProviderID.IsNull() {
+		id := m.ProviderID.ValueString()
+		providerID = &id
+	}
+
+	return keep.DeduplicationRuleRequest{
+		Name:              m.Name.ValueString(),
+		Description:       m.Description.ValueString(),
+		ProviderID:        providerID,
+		ProviderType:      m.ProviderType.ValueString(),
+		FingerprintFields: fingerprintFields,
+		FullDeduplication: m.FullDeduplication.ValueBool(),
+		IgnoreFields:      ignoreFields,
+	}, diags
+}
+
+// fromClientRule sets the model from a deduplication rule returned by the API
+func (m *deduplicationRuleResourceModel) fromClientRule(ctx context.Context, rule *keep.DeduplicationRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var d diag.Diagnostics
+
+	m.ID = types.StringValue(rule.ID)
+	m.Name = types.StringValue(rule.Name)
+	m.Description = optionalString(rule.Description)
+	m.ProviderID = optionalString(rule.ProviderID)
+	m.ProviderType = types.StringValue(rule.ProviderType)
+	m.FullDeduplication = types.BoolValue(rule.FullDeduplication)
+	m.CreatedBy = optionalString(rule.CreatedBy)
+
+	m.FingerprintFields, d = types.ListValueFrom(ctx, types.StringType, rule.FingerprintFields)
+	diags.Append(d...)
+
+	// No ignored fields matches an unset ignore_fields
+	if len(rule.IgnoreFields) > 0 || !m.IgnoreFields.IsNull() {
+		m.IgnoreFields, d = types.ListValueFrom(ctx, types.StringType, rule.IgnoreFields)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// Metadata returns the resource type name.
+func (r *deduplicationRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deduplication_rule"
+}
+
+// Schema defines the schema for the resource.
+func (r *deduplicationRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a deduplication rule in Keep. Deduplication rules decide which alerts from a provider are considered duplicates of each other.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the deduplication rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the deduplication rule.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the deduplication rule.",
+				Optional:    true,
+			},
+			"provider_id": schema.StringAttribute{
+				Description: "The ID of the installed provider the rule applies to. The provider must exist in Keep, which is checked during plan. Omit it for providers that are not installed, such as alerts sent by webhook.",
+				Optional:    true,
+			},
+			"provider_type": schema.StringAttribute{
+				Description: "The type of the provider the rule applies to (e.g., 'datadog', 'prometheus').",
+				Required:    true,
+			},
+			"fingerprint_fields": schema.ListAttribute{
+				Description: "Alert fields combined into the fingerprint. Alerts with the same fingerprint are deduplicated.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"full_deduplication": schema.BoolAttribute{
+				Description: "Whether alerts with the same fingerprint are dropped when they are otherwise identical, rather than updating the existing alert. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"ignore_fields": schema.ListAttribute{
+				Description: "Alert fields ignored when comparing alerts for full deduplication, such as timestamps.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"created_by": schema.StringAttribute{
+				Description: "Who created the deduplication rule.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *deduplicationRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan checks that the referenced provider exists, so a typo in
+// provider_id fails the plan instead of creating a rule that never applies.
+func (r *deduplicationRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is destroyed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan deduplicationRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The provider may be created in the same apply
+	if plan.ProviderID.IsNull() || plan.ProviderID.IsUnknown() || plan.ProviderType.IsUnknown() {
+		return
+	}
+
+	// Only check when the referenced provider changes
+	if !req.State.Raw.IsNull() {
+		var state deduplicationRuleResourceModel
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.ProviderID.Equal(state.ProviderID) && plan.ProviderType.Equal(state.ProviderType) {
+			return
+		}
+	}
+
+	providers, err := r.client.ListInstalledProviders(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Verify Provider",
+			"Could not list providers to check that provider_id exists: "+err.Error(),
+		)
+		return
+	}
+
+	matches := filterProviders(providers, providerFilter{
+		ID:        plan.ProviderID,
+		Name:      types.StringNull(),
+		Type:      plan.ProviderType,
+		Installed: types.BoolValue(true),
+	})
+	if len(matches) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("provider_id"),
+			"Provider Not Found",
+			fmt.Sprintf("No %s provider with ID %q exists in Keep. Install the provider first, for example with the keep_provider resource.", plan.ProviderType.ValueString(), plan.ProviderID.ValueString()),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *deduplicationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan deduplicationRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	ruleReq, diags := plan.toClientRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the deduplication rule via API
+	rule, err := r.client.CreateDeduplicationRule(ctx, ruleReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating deduplication rule",
+			"Could not create deduplication rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Created deduplication rule", map[string]interface{}{
+		"id":            rule.ID,
+		"provider_type": rule.ProviderType,
+	})
+
+	// Map response back to the plan
+	resp.Diagnostics.Append(plan.fromClientRule(ctx, rule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *deduplicationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state deduplicationRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	ruleID := state.ID.ValueString()
+	rule, err := r.client.GetDeduplicationRule(ctx, ruleID)
+	if err != nil {
+		if keep.IsNotFound(err) {
+			removeDeletedResource(ctx, resp, "deduplication rule", ruleID)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading deduplication rule",
+			"Could not read deduplication rule ID "+ruleID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromClientRule(ctx, rule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *deduplicationRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan deduplicationRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	ruleReq, diags := plan.toClientRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the deduplication rule via API
+	rule, err := r.client.UpdateDeduplicationRule(ctx, plan.ID.ValueString(), ruleReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating deduplication rule",
+			"Could not update deduplication rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response back to the plan
+	resp.Diagnostics.Append(plan.fromClientRule(ctx, rule)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *deduplicationRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state deduplicationRuleResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete deduplication rule via API
+	err := r.client.DeleteDeduplicationRule(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting deduplication rule",
+			"Could not delete deduplication rule, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *deduplicationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,137 @@
+// resource_deduplication_rule_test.go - Acceptance tests for the deduplication_rule resource
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccDeduplicationRuleResource(t *testing.T) {
+	// Skip if running short tests
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	resourceName := "keep_deduplication_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// A provider that does not exist fails the plan
+			{
+				Config:      testAccDeduplicationRuleResourceConfig(`provider_id = "does-not-exist"`, `["fingerprint"]`, false),
+				ExpectError: regexp.MustCompile(`Provider Not Found`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccDeduplicationRuleResourceConfig("", `["name", "labels.service"]`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeduplicationRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-deduplication-rule"),
+					resource.TestCheckResourceAttr(resourceName, "provider_type", "prometheus"),
+					resource.TestCheckResourceAttr(resourceName, "fingerprint_fields.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "full_deduplication", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Timeouts only live in configuration
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccDeduplicationRuleResourceConfig("", `["name"]`, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "fingerprint_fields.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "full_deduplication", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_fields.0", "lastReceived"),
+				),
+			},
+			// Install a provider so that its ID is known when the rule refers to it
+			{
+				Config: testAccDeduplicationRuleResourceConfig("", `["name"]`, true) + testAccDeduplicationRuleProviderConfig,
+			},
+			// An installed provider passes the plan-time check
+			{
+				Config: testAccDeduplicationRuleResourceConfig(`provider_id = keep_provider.prometheus.id`, `["name"]`, true) + testAccDeduplicationRuleProviderConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDeduplicationRuleExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "provider_id", "keep_provider.prometheus", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckDeduplicationRuleExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		client, err := getTestClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GetDeduplicationRule(context.Background(), rs.Primary.ID)
+		return err
+	}
+}
+
+// testAccDeduplicationRuleProviderConfig installs the provider referenced by provider_id
+const testAccDeduplicationRuleProviderConfig = `
+resource "keep_provider" "prometheus" {
+  name = "tf-acc-deduplication-prometheus"
+  type = "prometheus"
+  config = {
+    url = "http://prometheus:9090"
+  }
+}
+`
+
+func testAccDeduplicationRuleResourceConfig(providerID, fingerprintFields string, fullDeduplication bool) string {
+	apiURL := os.Getenv("KEEP_API_URL")
+	if apiURL == "" {
+		apiURL = "http://localhost:8080"
+	}
+
+	return fmt.Sprintf(`
+provider "keep" {
+  api_key = %q
+  api_url = %q
+}
+
+resource "keep_deduplication_rule" "test" {
+  name               = "tf-acc-deduplication-rule"
+  description        = "Created by Terraform"
+  provider_type      = "prometheus"
+  %s
+  fingerprint_fields = %s
+  full_deduplication = %t
+  ignore_fields      = ["lastReceived"]
+}
+`, os.Getenv("KEEP_API_KEY"), apiURL, providerID, fingerprintFields, fullDeduplication)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,7 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/keephq/terraform-provider-keep/internal/client"
+	"github.com/keephq/terraform-provider-keep/keep"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -30,20 +31,66 @@ func NewExtractionRuleResource() resource.Resource {
 
 // extractionRuleResource defines the resource implementation.
 type extractionRuleResource struct {
-	client *client.Client
+	client *keep.Client
 }
 
 // extractionRuleResourceModel maps the resource schema data.
 type extractionRuleResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Priority    types.Int64  `tfsdk:"priority"`
-	Disabled    types.Bool   `tfsdk:"disabled"`
-	Pre         types.Bool   `tfsdk:"pre"`
-	Condition   types.String `tfsdk:"condition"`
-	Attribute   types.String `tfsdk:"attribute"`
-	Regex       types.String `tfsdk:"regex"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Priority    types.Int64    `tfsdk:"priority"`
+	Disabled    types.Bool     `tfsdk:"disabled"`
+	Pre         types.Bool     `tfsdk:"pre"`
+	Condition   celExpression  `tfsdk:"condition"`
+	Attribute   types.String   `tfsdk:"attribute"`
+	Regex       types.String   `tfsdk:"regex"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// toClientRule converts the Terraform model to a keep.ExtractionRule
+func (m *extractionRuleResourceModel) toClientRule() keep.ExtractionRule {
+	description := m.Description.ValueString()
+	condition := m.Condition.ValueString()
+	attribute := m.Attribute.ValueString()
+	return keep.ExtractionRule{
+		Name:        m.Name.ValueString(),
+		Description: &description,
+		Priority:    int(m.Priority.ValueInt64()),
+		Disabled:    m.Disabled.ValueBool(),
+		Pre:         m.Pre.ValueBool(),
+		Condition:   &condition,
+		Attribute:   &attribute,
+		Regex:       m.Regex.ValueString(),
+	}
+}
+
+// fromClientRule sets the model from an extraction rule returned by the API.
+// Values the API leaves out keep their current value.
+func (m *extractionRuleResourceModel) fromClientRule(rule *keep.ExtractionRule) {
+	if rule.ID != "" {
+		m.ID = types.StringValue(string(rule.ID))
+	}
+	if rule.Name != "" {
+		m.Name = types.StringValue(rule.Name)
+	}
+	if rule.Description != nil {
+		m.Description = types.StringValue(*rule.Description)
+	} else if m.Description.IsUnknown() {
+		m.Description = types.StringNull()
+	}
+	m.Priority = types.Int64Value(int64(rule.Priority))
+	m.Disabled = types.BoolValue(rule.Disabled)
+	m.Pre = types.BoolValue(rule.Pre)
+	if rule.Condition != nil && *rule.Condition != "" {
+		m.Condition = newCELExpressionValue(*rule.Condition)
+	}
+	if rule.Attribute != nil {
+		m.Attribute = types.StringValue(*rule.Attribute)
+	}
+	if rule.Regex != "" {
+		m.Regex = types.StringValue(rule.Regex)
+	}
 }
 
 // Metadata returns the resource type name.
@@ -52,7 +99,7 @@ func (r *extractionRuleResource) Metadata(_ context.Context, req resource.Metada
 }
 
 // Schema defines the schema for the resource.
-func (r *extractionRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *extractionRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages an extraction rule in Keep. Extraction rules define how to extract and transform data from incoming alerts.",
 		Attributes: map[string]schema.Attribute{
@@ -91,8 +138,9 @@ func (r *extractionRuleResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Default:     booldefault.StaticBool(false),
 			},
 			"condition": schema.StringAttribute{
-				Description: "CEL expression that determines when this rule should be applied. If not specified, the rule will always be applied.",
+				Description: "CEL expression that determines when this rule should be applied, evaluated against the alert fields. It is parsed when the configuration is validated. If not specified, the rule will always be applied.",
 				Optional:    true,
+				CustomType:  celExpressionType{},
 			},
 			"attribute": schema.StringAttribute{
 				Description: "The attribute to extract from the alert.",
@@ -103,6 +151,9 @@ func (r *extractionRuleResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Required:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -112,11 +163,11 @@ func (r *extractionRuleResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(*keep.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -134,20 +185,17 @@ func (r *extractionRuleResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	// Create new extraction rule
-	extractionRule := map[string]interface{}{
-		"name":        plan.Name.ValueString(),
-		"description": plan.Description.ValueString(),
-		"priority":    int(plan.Priority.ValueInt64()),
-		"disabled":    plan.Disabled.ValueBool(),
-		"pre":         plan.Pre.ValueBool(),
-		"condition":   plan.Condition.ValueString(),
-		"attribute":   plan.Attribute.ValueString(),
-		"regex":       plan.Regex.ValueString(),
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create the extraction rule via API
-	createdRule, err := r.client.CreateExtractionRule(ctx, extractionRule)
+	createdRule, err := r.client.CreateExtractionRule(ctx, plan.toClientRule())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating extraction rule",
@@ -155,31 +203,9 @@ func (r *extractionRuleResource) Create(ctx context.Context, req resource.Create
 		)
 		return
 	}
-	
+
 	// Map response back to the plan
-	plan.ID = types.StringValue(fmt.Sprintf("%d", int(createdRule["id"].(float64))))
-	plan.Name = types.StringValue(createdRule["name"].(string))
-	if desc, ok := createdRule["description"].(string); ok {
-		plan.Description = types.StringValue(desc)
-	}
-	if priority, ok := createdRule["priority"].(float64); ok {
-		plan.Priority = types.Int64Value(int64(priority))
-	}
-	if disabled, ok := createdRule["disabled"].(bool); ok {
-		plan.Disabled = types.BoolValue(disabled)
-	}
-	if pre, ok := createdRule["pre"].(bool); ok {
-		plan.Pre = types.BoolValue(pre)
-	}
-	if condition, ok := createdRule["condition"].(string); ok && condition != "" {
-		plan.Condition = types.StringValue(condition)
-	}
-	if attribute, ok := createdRule["attribute"].(string); ok {
-		plan.Attribute = types.StringValue(attribute)
-	}
-	if regex, ok := createdRule["regex"].(string); ok {
-		plan.Regex = types.StringValue(regex)
-	}
+	plan.fromClientRule(createdRule)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -199,10 +225,23 @@ func (r *extractionRuleResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get extraction rule from API
 	ruleID := state.ID.ValueString()
 	extractionRule, err := r.client.GetExtractionRule(ctx, ruleID)
 	if err != nil {
+		if keep.IsNotFound(err) {
+			removeDeletedResource(ctx, resp, "extraction rule", ruleID)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading extraction rule",
 			"Could not read extraction rule ID "+ruleID+": "+err.Error(),
@@ -211,41 +250,7 @@ func (r *extractionRuleResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 	
 	// Update the state with the response
-	state.ID = types.StringValue(fmt.Sprintf("%d", int(extractionRule["id"].(float64))))
-	state.Name = types.StringValue(extractionRule["name"].(string))
-	if desc, ok := extractionRule["description"].(string); ok {
-		state.Description = types.StringValue(desc)
-	}
-	if priority, ok := extractionRule["priority"].(float64); ok {
-		state.Priority = types.Int64Value(int64(priority))
-	}
-	if disabled, ok := extractionRule["disabled"].(bool); ok {
-		state.Disabled = types.BoolValue(disabled)
-	}
-	if pre, ok := extractionRule["pre"].(bool); ok {
-		state.Pre = types.BoolValue(pre)
-	}
-	if condition, ok := extractionRule["condition"].(string); ok && condition != "" {
-		state.Condition = types.StringValue(condition)
-	}
-	if attribute, ok := extractionRule["attribute"].(string); ok {
-		state.Attribute = types.StringValue(attribute)
-	}
-	if regex, ok := extractionRule["regex"].(string); ok {
-		state.Regex = types.StringValue(regex)
-	}
-
-
-	// Map response to state
-	// state.ID = types.StringValue(fmt.Sprintf("%d", int(extractionRule["id"].(float64))))
-	// state.Name = types.StringValue(extractionRule["name"].(string))
-	// state.Description = types.StringValue(extractionRule["description"].(string))
-	// state.Priority = types.Int64Value(int64(extractionRule["priority"].(float64)))
-	// state.Disabled = types.BoolValue(extractionRule["disabled"].(bool))
-	// state.Pre = types.BoolValue(extractionRule["pre"].(bool))
-	// state.Condition = types.StringValue(extractionRule["condition"].(string))
-	// state.Attribute = types.StringValue(extractionRule["attribute"].(string))
-	// state.Regex = types.StringValue(extractionRule["regex"].(string))
+	state.fromClientRule(extractionRule)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -273,18 +278,17 @@ func (r *extractionRuleResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	// Update extraction rule via API
-	updatedRule, err := r.client.UpdateExtractionRule(ctx, state.ID.ValueString(), map[string]interface{}{
-		"name":        plan.Name.ValueString(),
-		"description": plan.Description.ValueString(),
-		"priority":    int(plan.Priority.ValueInt64()),
-		"disabled":    plan.Disabled.ValueBool(),
-		"pre":         plan.Pre.ValueBool(),
-		"condition":   plan.Condition.ValueString(),
-		"attribute":   plan.Attribute.ValueString(),
-		"regex":       plan.Regex.ValueString(),
-	})
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update extraction rule via API
+	updatedRule, err := r.client.UpdateExtractionRule(ctx, state.ID.ValueString(), plan.toClientRule())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating extraction rule",
@@ -294,42 +298,7 @@ func (r *extractionRuleResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Update the plan with the response data
-	if name, ok := updatedRule["name"].(string); ok {
-		plan.Name = types.StringValue(name)
-	}
-	if desc, ok := updatedRule["description"].(string); ok {
-		plan.Description = types.StringValue(desc)
-	}
-	if priority, ok := updatedRule["priority"].(float64); ok {
-		plan.Priority = types.Int64Value(int64(priority))
-	}
-	if disabled, ok := updatedRule["disabled"].(bool); ok {
-		plan.Disabled = types.BoolValue(disabled)
-	}
-	if pre, ok := updatedRule["pre"].(bool); ok {
-		plan.Pre = types.BoolValue(pre)
-	}
-	if condition, ok := updatedRule["condition"].(string); ok && condition != "" {
-		plan.Condition = types.StringValue(condition)
-	}
-	if attribute, ok := updatedRule["attribute"].(string); ok {
-		plan.Attribute = types.StringValue(attribute)
-	}
-	if regex, ok := updatedRule["regex"].(string); ok {
-		plan.Regex = types.StringValue(regex)
-	}
-
-
-	// Map response to schema
-	// plan.ID = types.StringValue(fmt.Sprintf("%d", int(updatedRule["id"].(float64))))
-	// plan.Name = types.StringValue(updatedRule["name"].(string))
-	// plan.Description = types.StringValue(updatedRule["description"].(string))
-	// plan.Priority = types.Int64Value(int64(updatedRule["priority"].(float64)))
-	// plan.Disabled = types.BoolValue(updatedRule["disabled"].(bool))
-	// plan.Pre = types.BoolValue(updatedRule["pre"].(bool))
-	// plan.Condition = types.StringValue(updatedRule["condition"].(string))
-	// plan.Attribute = types.StringValue(updatedRule["attribute"].(string))
-	// plan.Regex = types.StringValue(updatedRule["regex"].(string))
+	plan.fromClientRule(updatedRule)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -349,6 +318,15 @@ func (r *extractionRuleResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete extraction rule via API
 	err := r.client.DeleteExtractionRule(ctx, state.ID.ValueString())
 	if err != nil {
@@ -9,7 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/keephq/terraform-provider-keep/internal/client"
+	"github.com/keephq/terraform-provider-keep/keep"
 )
 
 func TestAccExtractionRuleResource(t *testing.T) {
@@ -35,7 +35,7 @@ func TestAccExtractionRuleResource(t *testing.T) {
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
-				Config: testAccExtractionRuleResourceConfig(ruleName, attribute, regex, false, "test condition"),
+				Config: testAccExtractionRuleResourceConfig(ruleName, attribute, regex, false, `source == "test"`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckExtractionRuleExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "name", ruleName),
@@ -43,8 +43,9 @@ func TestAccExtractionRuleResource(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "regex", regex),
 					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
 					resource.TestCheckResourceAttr(resourceName, "pre", "false"),
-					resource.TestCheckResourceAttr(resourceName, "condition", "test condition"),
+					resource.TestCheckResourceAttr(resourceName, "condition", `source == "test"`),
 					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "timeouts.create", "2m"),
 				),
 			},
 			// ImportState testing
@@ -52,16 +53,18 @@ func TestAccExtractionRuleResource(t *testing.T) {
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
+				// Timeouts only live in configuration
+				ImportStateVerifyIgnore: []string{"timeouts"},
 			},
 			// Update and Read testing
 			{
-				Config: testAccExtractionRuleResourceConfig(ruleName, attribute, updatedRegex, true, "updated condition"),
+				Config: testAccExtractionRuleResourceConfig(ruleName, attribute, updatedRegex, true, `source == "updated"`),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					testAccCheckExtractionRuleExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "name", ruleName),
 					resource.TestCheckResourceAttr(resourceName, "regex", updatedRegex),
 					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
-					resource.TestCheckResourceAttr(resourceName, "condition", "updated condition"),
+					resource.TestCheckResourceAttr(resourceName, "condition", `source == "updated"`),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -124,6 +127,11 @@ resource "keep_extraction_rule" "test" {
   condition   = %q
   priority    = 10
   pre         = false
+
+  timeouts {
+    create = "2m"
+    delete = "2m"
+  }
 }`, 
 	os.Getenv("KEEP_API_KEY"), 
 	apiURL,
@@ -135,7 +143,7 @@ resource "keep_extraction_rule" "test" {
 }
 
 // getTestClient creates a test client using environment variables
-func getTestClient() (*client.Client, error) {
+func getTestClient() (*keep.Client, error) {
 	apiURL := os.Getenv("KEEP_API_URL")
 	if apiURL == "" {
 		apiURL = "http://localhost:8080"
@@ -146,7 +154,7 @@ func getTestClient() (*client.Client, error) {
 		return nil, fmt.Errorf("KEEP_API_KEY environment variable must be set for acceptance tests")
 	}
 
-	c, err := client.NewClient(apiURL, apiKey)
+	c, err := keep.NewClient(apiURL, apiKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create client: %w", err)
 	}
@@ -0,0 +1,465 @@
+// resource_incident.go - Resource implementation for KeepHQ incidents
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+// incidentStatusFiring is the status of a newly created incident.
+const incidentStatusFiring = "firing"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &incidentResource{}
+	_ resource.ResourceWithConfigure   = &incidentResource{}
+	_ resource.ResourceWithImportState = &incidentResource{}
+)
+
+// NewIncidentResource is a helper function to simplify the provider implementation.
+func NewIncidentResource() resource.Resource {
+	return &incidentResource{}
+}
+
+// incidentResource defines the resource implementation.
+type incidentResource struct {
+	client *keep.Client
+}
+
+// incidentResourceModel maps the resource schema data.
+type incidentResourceModel struct {
+	ID                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	Summary           types.String   `tfsdk:"summary"`
+	Severity          types.String   `tfsdk:"severity"`
+	Assignee          types.String   `tfsdk:"assignee"`
+	Status            types.String   `tfsdk:"status"`
+	AlertFingerprints types.Set      `tfsdk:"alert_fingerprints"`
+	CreationTime      types.String   `tfsdk:"creation_time"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+// toClientIncident converts the Terraform model to a keep.IncidentRequest
+func (m *incidentResourceModel) toClientIncident() keep.IncidentRequest {
+	return keep.IncidentRequest{
+		UserGeneratedName: m.Name.ValueString(),
+		UserSummary:       m.Summary.ValueString(),
+		Assignee:          m.Assignee.ValueString(),
+		Severity:          m.Severity.ValueString(),
+	}
+}
+
+// fromClientIncident sets the model from an incident returned by the API.
+func (m *incidentResourceModel) fromClientIncident(incident *keep.Incident) {
+	if incident.ID != "" {
+		m.ID = types.StringValue(incident.ID)
+	}
+	if incident.UserGeneratedName != "" {
+		m.Name = types.StringValue(incident.UserGeneratedName)
+	}
+	m.Summary = optionalStringPointer(incident.UserSummary)
+	m.Assignee = optionalStringPointer(incident.Assignee)
+	if incident.Severity != "" {
+		m.Severity = types.StringValue(incident.Severity)
+	}
+	if incident.Status != "" {
+		m.Status = types.StringValue(incident.Status)
+	}
+	m.CreationTime = optionalStringPointer(incident.CreationTime)
+}
+
+// Metadata returns the resource type name.
+func (r *incidentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident"
+}
+
+// Schema defines the schema for the resource.
+func (r *incidentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an incident in Keep and the alerts linked to it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the incident.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the incident.",
+				Required:    true,
+			},
+			"summary": schema.StringAttribute{
+				Description: "A summary of the incident.",
+				Optional:    true,
+			},
+			"severity": schema.StringAttribute{
+				Description: "The severity of the incident: critical, high, warning, info or low. Keep assigns one when not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("critical", "high", "warning", "info", "low"),
+				},
+			},
+			"assignee": schema.StringAttribute{
+				Description: "The user the incident is assigned to.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the incident: firing, acknowledged or resolved. Defaults to firing.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(incidentStatusFiring),
+				Validators: []validator.String{
+					stringvalidator.OneOf(incidentStatusFiring, "acknowledged", "resolved"),
+				},
+			},
+			"alert_fingerprints": schema.SetAttribute{
+				Description: "Fingerprints of the alerts linked to the incident. Alerts linked outside of Terraform are unlinked on the next apply.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"creation_time": schema.StringAttribute{
+				Description: "When the incident was created.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *incidentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// syncAlerts links and unlinks alerts so the incident holds exactly the planned fingerprints.
+func (r *incidentResource) syncAlerts(ctx context.Context, id string, current []string, planned types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var want []string
+	if !planned.IsNull() {
+		diags.Append(planned.ElementsAs(ctx, &want, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	toAdd, toRemove := diffStrings(current, want)
+
+	tflog.Debug(ctx, "Syncing incident alerts", map[string]interface{}{
+		"id":      id,
+		"add":     len(toAdd),
+		"remove":  len(toRemove),
+		"current": len(current),
+	})
+
+	if len(toRemove) > 0 {
+		if err := r.client.RemoveAlertsFromIncident(ctx, id, toRemove); err != nil {
+			diags.AddError(
+				"Error unlinking incident alerts",
+				"Could not unlink alerts from incident ID "+id+": "+err.Error(),
+			)
+			return diags
+		}
+	}
+	if len(toAdd) > 0 {
+		if err := r.client.AddAlertsToIncident(ctx, id, toAdd); err != nil {
+			diags.AddError(
+				"Error linking incident alerts",
+				"Could not link alerts to incident ID "+id+": "+err.Error(),
+			)
+		}
+	}
+
+	return diags
+}
+
+// diffStrings returns the values of want missing from have, and the values of have missing from want.
+func diffStrings(have, want []string) (missing, extra []string) {
+	haveSet := make(map[string]bool, len(have))
+	for _, v := range have {
+		haveSet[v] = true
+	}
+	wantSet := make(map[string]bool, len(want))
+	for _, v := range want {
+		wantSet[v] = true
+		if !haveSet[v] {
+			missing = append(missing, v)
+		}
+	}
+	for _, v := range have {
+		if !wantSet[v] {
+			extra = append(extra, v)
+		}
+	}
+	return missing, extra
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *incidentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan incidentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create the incident via API
+	incident, err := r.client.CreateIncident(ctx, plan.toClientIncident())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating incident",
+			"Could not create incident, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	id := incident.ID
+	if id == "" {
+		resp.Diagnostics.AddError(
+			"Error creating incident",
+			fmt.Sprintf("Keep did not return an ID for the created incident: %+v", incident),
+		)
+		return
+	}
+
+	// Save the ID first so a failure below does not leave the incident unmanaged
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.syncAlerts(ctx, id, nil, plan.AlertFingerprints)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Status.ValueString() != incidentStatusFiring {
+		if err := r.client.ChangeIncidentStatus(ctx, id, plan.Status.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error changing incident status",
+				"Could not change status of incident ID "+id+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Map response back to the plan, keeping the planned status and alerts
+	status := plan.Status
+	plan.fromClientIncident(incident)
+	plan.Status = status
+	if plan.Severity.IsUnknown() {
+		plan.Severity = types.StringNull()
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *incidentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state incidentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	incidentID := state.ID.ValueString()
+	incident, err := r.client.GetIncident(ctx, incidentID)
+	if err != nil {
+		if keep.IsNotFound(err) {
+			removeDeletedResource(ctx, resp, "incident", incidentID)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading incident",
+			"Could not read incident ID "+incidentID+": "+err.Error(),
+		)
+		return
+	}
+
+	state.fromClientIncident(incident)
+
+	fingerprints, err := r.client.ListIncidentAlertFingerprints(ctx, incidentID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading incident alerts",
+			"Could not list alerts of incident ID "+incidentID+": "+err.Error(),
+		)
+		return
+	}
+
+	// An incident without alerts matches an unset alert_fingerprints
+	if len(fingerprints) > 0 || !state.AlertFingerprints.IsNull() {
+		state.AlertFingerprints, diags = types.SetValueFrom(ctx, types.StringType, fingerprints)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *incidentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan incidentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get current state
+	var state incidentResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	incidentID := state.ID.ValueString()
+
+	// Update the incident via API
+	incident, err := r.client.UpdateIncident(ctx, incidentID, plan.toClientIncident())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating incident",
+			"Could not update incident, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	if !plan.AlertFingerprints.Equal(state.AlertFingerprints) {
+		var current []string
+		if !state.AlertFingerprints.IsNull() {
+			resp.Diagnostics.Append(state.AlertFingerprints.ElementsAs(ctx, &current, false)...)
+		}
+		resp.Diagnostics.Append(r.syncAlerts(ctx, incidentID, current, plan.AlertFingerprints)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.Status.Equal(state.Status) {
+		if err := r.client.ChangeIncidentStatus(ctx, incidentID, plan.Status.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error changing incident status",
+				"Could not change status of incident ID "+incidentID+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Map response back to the plan, keeping the planned status
+	status := plan.Status
+	plan.fromClientIncident(incident)
+	plan.Status = status
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *incidentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state incidentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete incident via API
+	err := r.client.DeleteIncident(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting incident",
+			"Could not delete incident, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *incidentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,121 @@
+// resource_incident_test.go - Tests for the incident resource
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestDiffStrings(t *testing.T) {
+	missing, extra := diffStrings([]string{"a", "b", "c"}, []string{"b", "c", "d", "e"})
+	if !reflect.DeepEqual(missing, []string{"d", "e"}) {
+		t.Errorf("expected missing [d e], got %v", missing)
+	}
+	if !reflect.DeepEqual(extra, []string{"a"}) {
+		t.Errorf("expected extra [a], got %v", extra)
+	}
+
+	missing, extra = diffStrings(nil, nil)
+	if len(missing) != 0 || len(extra) != 0 {
+		t.Errorf("expected no differences, got %v and %v", missing, extra)
+	}
+}
+
+func TestAccIncidentResource(t *testing.T) {
+	// Skip if running short tests
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	resourceName := "keep_incident.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIncidentResourceConfig("tf-acc-incident", "critical", "firing"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIncidentExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-incident"),
+					resource.TestCheckResourceAttr(resourceName, "summary", "Created by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "critical"),
+					resource.TestCheckResourceAttr(resourceName, "status", "firing"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Timeouts only live in configuration
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			// Update and resolve testing
+			{
+				Config: testAccIncidentResourceConfig("tf-acc-incident-renamed", "warning", "resolved"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-incident-renamed"),
+					resource.TestCheckResourceAttr(resourceName, "severity", "warning"),
+					resource.TestCheckResourceAttr(resourceName, "status", "resolved"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckIncidentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		client, err := getTestClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GetIncident(context.Background(), rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccIncidentResourceConfig(name, severity, status string) string {
+	apiURL := os.Getenv("KEEP_API_URL")
+	if apiURL == "" {
+		apiURL = "http://localhost:8080"
+	}
+
+	return fmt.Sprintf(`
+provider "keep" {
+  api_key = %q
+  api_url = %q
+}
+
+resource "keep_incident" "test" {
+  name     = %q
+  summary  = "Created by Terraform"
+  severity = %q
+  status   = %q
+}
+`, os.Getenv("KEEP_API_KEY"), apiURL, name, severity, status)
+}
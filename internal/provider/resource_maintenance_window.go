@@ -0,0 +1,467 @@
+// resource_maintenance_window.go - Resource implementation for KeepHQ maintenance windows
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &maintenanceWindowResource{}
+	_ resource.ResourceWithConfigure        = &maintenanceWindowResource{}
+	_ resource.ResourceWithImportState      = &maintenanceWindowResource{}
+	_ resource.ResourceWithConfigValidators = &maintenanceWindowResource{}
+)
+
+// NewMaintenanceWindowResource is a helper function to simplify the provider implementation.
+func NewMaintenanceWindowResource() resource.Resource {
+	return &maintenanceWindowResource{}
+}
+
+// maintenanceWindowResource defines the resource implementation.
+type maintenanceWindowResource struct {
+	client *keep.Client
+}
+
+// maintenanceWindowResourceModel maps the resource schema data.
+type maintenanceWindowResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	Description    types.String   `tfsdk:"description"`
+	CELQuery       celExpression  `tfsdk:"cel_query"`
+	StartTime      types.String   `tfsdk:"start_time"`
+	EndTime        types.String   `tfsdk:"end_time"`
+	Duration       types.String   `tfsdk:"duration"`
+	Enabled        types.Bool     `tfsdk:"enabled"`
+	Suppress       types.Bool     `tfsdk:"suppress"`
+	IgnoreStatuses types.List     `tfsdk:"ignore_statuses"`
+	CreatedBy      types.String   `tfsdk:"created_by"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+// parseKeepTime parses a timestamp returned by Keep. Timestamps without a
+// time zone offset are in UTC.
+func parseKeepTime(value string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", "2006-01-02 15:04:05.999999999"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unrecognized timestamp %q", value)
+}
+
+// sameInstant returns current when it is a timestamp denoting t, preserving the
+// configured time zone offset, and otherwise t formatted in UTC.
+func sameInstant(current types.String, t time.Time) types.String {
+	if !current.IsNull() && !current.IsUnknown() {
+		if c, err := time.Parse(time.RFC3339, current.ValueString()); err == nil && c.Equal(t) {
+			return current
+		}
+	}
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
+
+// toClientRequest converts the Terraform model to a keep.MaintenanceWindowRequest
+func (m *maintenanceWindowResourceModel) toClientRequest(ctx context.Context) (keep.MaintenanceWindowRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	start, err := time.Parse(time.RFC3339, m.StartTime.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("start_time"), "Invalid Timestamp", err.Error())
+		return keep.MaintenanceWindowRequest{}, diags
+	}
+
+	var duration time.Duration
+	if !m.Duration.IsNull() && !m.Duration.IsUnknown() {
+		duration, err = time.ParseDuration(m.Duration.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("duration"), "Invalid Duration", err.Error())
+			return keep.MaintenanceWindowRequest{}, diags
+		}
+	} else {
+		end, err := time.Parse(time.RFC3339, m.EndTime.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("end_time"), "Invalid Timestamp", err.Error())
+			return keep.MaintenanceWindowRequest{}, diags
+		}
+		duration = end.Sub(start)
+	}
+	if duration < time.Second {
+		diags.AddError(
+			"Invalid Maintenance Window",
+			fmt.Sprintf("The maintenance window must end at least one second after start_time, got a duration of %s.", duration),
+		)
+		return keep.MaintenanceWindowRequest{}, diags
+	}
+
+	ignoreStatuses := []string{}
+	if !m.IgnoreStatuses.IsNull() {
+		diags.Append(m.IgnoreStatuses.ElementsAs(ctx, &ignoreStatuses, false)...)
+	}
+
+	return keep.MaintenanceWindowRequest{
+		Name:            m.Name.ValueString(),
+		Description:     m.Description.ValueString(),
+		CELQuery:        m.CELQuery.ValueString(),
+		StartTime:       start.UTC().Format(time.RFC3339),
+		DurationSeconds: int64(duration / time.Second),
+		Suppress:        m.Suppress.ValueBool(),
+		Enabled:         m.Enabled.ValueBool(),
+		IgnoreStatuses:  ignoreStatuses,
+	}, diags
+}
+
+// fromClientWindow sets the model from a maintenance window returned by the
+// API. Timestamps and durations equivalent to the configured ones are kept as
+// written, so time zone offsets and duration notation do not cause diffs.
+func (m *maintenanceWindowResourceModel) fromClientWindow(ctx context.Context, w *keep.MaintenanceWindow) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(strconv.FormatInt(w.ID, 10))
+	m.Name = types.StringValue(w.Name)
+	m.Description = optionalString(w.Description)
+	m.CELQuery = newCELExpressionValue(w.CELQuery)
+	m.Enabled = types.BoolValue(w.Enabled)
+	m.Suppress = types.BoolValue(w.Suppress)
+	m.CreatedBy = optionalString(w.CreatedBy)
+
+	start, err := parseKeepTime(w.StartTime)
+	if err != nil {
+		diags.AddError("Error reading maintenance window", "Could not parse start_time: "+err.Error())
+		return diags
+	}
+	m.StartTime = sameInstant(m.StartTime, start)
+
+	duration := time.Duration(w.DurationSeconds) * time.Second
+	if current, err := time.ParseDuration(m.Duration.ValueString()); m.Duration.IsNull() || m.Duration.IsUnknown() || err != nil || current != duration {
+		m.Duration = types.StringValue(duration.String())
+	}
+
+	end := start.Add(duration)
+	if w.EndTime != "" {
+		if parsed, err := parseKeepTime(w.EndTime); err == nil {
+			end = parsed
+		}
+	}
+	m.EndTime = sameInstant(m.EndTime, end)
+
+	// No ignored statuses matches an unset ignore_statuses
+	if len(w.IgnoreStatuses) > 0 || !m.IgnoreStatuses.IsNull() {
+		var d diag.Diagnostics
+		m.IgnoreStatuses, d = types.ListValueFrom(ctx, types.StringType, w.IgnoreStatuses)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// Metadata returns the resource type name.
+func (r *maintenanceWindowResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_window"
+}
+
+// Schema defines the schema for the resource.
+func (r *maintenanceWindowResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a maintenance window in Keep. Alerts matching the CEL query while the window is active are suppressed or ignored.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the maintenance window.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the maintenance window.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the maintenance window.",
+				Optional:    true,
+			},
+			"cel_query": schema.StringAttribute{
+				Description: "CEL expression selecting the alerts covered by the maintenance window, evaluated against the alert fields. It is parsed when the configuration is validated.",
+				Required:    true,
+				CustomType:  celExpressionType{},
+			},
+			"start_time": schema.StringAttribute{
+				Description: "When the maintenance window starts, as an RFC3339 timestamp in whole seconds with a time zone offset, such as 2025-06-01T22:00:00+02:00.",
+				Required:    true,
+				Validators: []validator.String{
+					rfc3339Validator{wholeSeconds: true},
+				},
+			},
+			"end_time": schema.StringAttribute{
+				Description: "When the maintenance window ends, as an RFC3339 timestamp in whole seconds. Exactly one of end_time or duration must be set; the other is computed.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					rfc3339Validator{wholeSeconds: true},
+				},
+			},
+			"duration": schema.StringAttribute{
+				Description: "How long the maintenance window lasts, as a duration in whole seconds such as \"90m\" or \"2h\". Exactly one of end_time or duration must be set; the other is computed.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					durationValidator{wholeSeconds: true},
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the maintenance window is enabled. Defaults to true.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"suppress": schema.BoolAttribute{
+				Description: "Whether matching alerts are shown with a suppressed status (true) or not shown at all (false). Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"ignore_statuses": schema.ListAttribute{
+				Description: "Alert statuses the maintenance window does not apply to, such as resolved or acknowledged.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("firing", "resolved", "acknowledged", "suppressed", "pending")),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Description: "Who created the maintenance window.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// ConfigValidators validates that the window length is set exactly once.
+func (r *maintenanceWindowResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("end_time"),
+			path.MatchRoot("duration"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *maintenanceWindowResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *maintenanceWindowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan maintenanceWindowResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	windowReq, diags := plan.toClientRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the maintenance window via API
+	window, err := r.client.CreateMaintenanceWindow(ctx, windowReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating maintenance window",
+			"Could not create maintenance window, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Created maintenance window", map[string]interface{}{
+		"id":               window.ID,
+		"start_time":       windowReq.StartTime,
+		"duration_seconds": windowReq.DurationSeconds,
+	})
+
+	// Map response back to the plan
+	resp.Diagnostics.Append(plan.fromClientWindow(ctx, window)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *maintenanceWindowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state maintenanceWindowResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	windowID := state.ID.ValueString()
+	window, err := r.client.GetMaintenanceWindow(ctx, windowID)
+	if err != nil {
+		if keep.IsNotFound(err) {
+			removeDeletedResource(ctx, resp, "maintenance window", windowID)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading maintenance window",
+			"Could not read maintenance window ID "+windowID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromClientWindow(ctx, window)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *maintenanceWindowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan maintenanceWindowResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	windowReq, diags := plan.toClientRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the maintenance window via API
+	window, err := r.client.UpdateMaintenanceWindow(ctx, plan.ID.ValueString(), windowReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating maintenance window",
+			"Could not update maintenance window, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response back to the plan
+	resp.Diagnostics.Append(plan.fromClientWindow(ctx, window)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *maintenanceWindowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state maintenanceWindowResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete maintenance window via API
+	err := r.client.DeleteMaintenanceWindow(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting maintenance window",
+			"Could not delete maintenance window, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *maintenanceWindowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
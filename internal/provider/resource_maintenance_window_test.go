@@ -0,0 +1,202 @@
+// resource_maintenance_window_test.go - Acceptance tests for the maintenance_window resource
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestParseKeepTime(t *testing.T) {
+	want := time.Date(2025, 6, 1, 20, 0, 0, 0, time.UTC)
+	for _, value := range []string{
+		"2025-06-01T20:00:00Z",
+		"2025-06-01T22:00:00+02:00",
+		"2025-06-01T20:00:00",
+		"2025-06-01T20:00:00.000000",
+		"2025-06-01 20:00:00",
+	} {
+		got, err := parseKeepTime(value)
+		if err != nil {
+			t.Errorf("parseKeepTime(%q) returned error: %s", value, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("parseKeepTime(%q) = %s, want %s", value, got, want)
+		}
+	}
+
+	if _, err := parseKeepTime("June 1st"); err == nil {
+		t.Error("expected an error for an unrecognized timestamp")
+	}
+}
+
+func TestSameInstant(t *testing.T) {
+	instant := time.Date(2025, 6, 1, 20, 0, 0, 0, time.UTC)
+	tests := []struct {
+		current types.String
+		want    string
+	}{
+		{types.StringValue("2025-06-01T22:00:00+02:00"), "2025-06-01T22:00:00+02:00"},
+		{types.StringValue("2025-06-01T20:00:00Z"), "2025-06-01T20:00:00Z"},
+		{types.StringValue("2025-06-01T21:00:00Z"), "2025-06-01T20:00:00Z"},
+		{types.StringNull(), "2025-06-01T20:00:00Z"},
+		{types.StringUnknown(), "2025-06-01T20:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		if got := sameInstant(tt.current, instant).ValueString(); got != tt.want {
+			t.Errorf("sameInstant(%s) = %q, want %q", tt.current, got, tt.want)
+		}
+	}
+}
+
+func TestMaintenanceWindowDurationWholeSeconds(t *testing.T) {
+	tests := map[string]bool{
+		"90s":   true,
+		"2h30m": true,
+		"90.5s": false,
+		"500ms": false,
+	}
+
+	for value, valid := range tests {
+		req := validator.StringRequest{Path: path.Root("duration"), ConfigValue: types.StringValue(value)}
+		var resp validator.StringResponse
+		durationValidator{wholeSeconds: true}.ValidateString(context.Background(), req, &resp)
+		if got := !resp.Diagnostics.HasError(); got != valid {
+			t.Errorf("duration %q valid = %v, want %v", value, got, valid)
+		}
+	}
+}
+
+func TestMaintenanceWindowTimestampWholeSeconds(t *testing.T) {
+	tests := map[string]bool{
+		"2025-06-01T22:00:00Z":        true,
+		"2025-06-01T22:00:00+02:00":   true,
+		"2025-06-01T22:00:00.5Z":      false,
+		"2025-06-01T22:00:00.000001Z": false,
+		"2025-06-01 22:00:00":         false,
+	}
+
+	for value, valid := range tests {
+		req := validator.StringRequest{Path: path.Root("start_time"), ConfigValue: types.StringValue(value)}
+		var resp validator.StringResponse
+		rfc3339Validator{wholeSeconds: true}.ValidateString(context.Background(), req, &resp)
+		if got := !resp.Diagnostics.HasError(); got != valid {
+			t.Errorf("timestamp %q valid = %v, want %v", value, got, valid)
+		}
+	}
+}
+
+func TestAccMaintenanceWindowResource(t *testing.T) {
+	// Skip if running short tests
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	resourceName := "keep_maintenance_window.test"
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour).In(time.FixedZone("", 2*60*60))
+	startTime := start.Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// An end time before the start time fails the apply
+			{
+				Config:      testAccMaintenanceWindowResourceConfig(startTime, fmt.Sprintf("end_time = %q", start.Add(-time.Hour).Format(time.RFC3339))),
+				ExpectError: regexp.MustCompile(`Invalid Maintenance Window`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccMaintenanceWindowResourceConfig(startTime, `duration = "2h"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMaintenanceWindowExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-maintenance-window"),
+					resource.TestCheckResourceAttr(resourceName, "start_time", startTime),
+					resource.TestCheckResourceAttr(resourceName, "duration", "2h"),
+					resource.TestCheckResourceAttr(resourceName, "end_time", start.Add(2*time.Hour).UTC().Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Timeouts only live in configuration, and imported
+				// timestamps and durations are normalized
+				ImportStateVerifyIgnore: []string{"timeouts", "start_time", "duration"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccMaintenanceWindowResourceConfig(startTime, fmt.Sprintf("end_time = %q", start.Add(3*time.Hour).Format(time.RFC3339))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "end_time", start.Add(3*time.Hour).Format(time.RFC3339)),
+					resource.TestCheckResourceAttr(resourceName, "duration", "3h0m0s"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckMaintenanceWindowExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		client, err := getTestClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GetMaintenanceWindow(context.Background(), rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccMaintenanceWindowResourceConfig(startTime, length string) string {
+	apiURL := os.Getenv("KEEP_API_URL")
+	if apiURL == "" {
+		apiURL = "http://localhost:8080"
+	}
+
+	return fmt.Sprintf(`
+provider "keep" {
+  api_key = %q
+  api_url = %q
+}
+
+resource "keep_maintenance_window" "test" {
+  name            = "tf-acc-maintenance-window"
+  description     = "Created by Terraform"
+  cel_query       = "source == \"prometheus\""
+  start_time      = %q
+  %s
+  suppress        = true
+  ignore_statuses = ["resolved"]
+}
+`, os.Getenv("KEEP_API_KEY"), apiURL, startTime, length)
+}
@@ -4,29 +4,44 @@ package provider
 import (
 	"context"
 	"encoding/csv"
+	"errors"
 	"fmt"
-	"strconv"
+	"path/filepath"
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/keephq/terraform-provider-keep/internal/client"
+	"github.com/keephq/terraform-provider-keep/keep"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &mappingRuleResource{}
-	_ resource.ResourceWithConfigure   = &mappingRuleResource{}
-	_ resource.ResourceWithImportState = &mappingRuleResource{}
+	_ resource.Resource                   = &mappingRuleResource{}
+	_ resource.ResourceWithConfigure      = &mappingRuleResource{}
+	_ resource.ResourceWithImportState    = &mappingRuleResource{}
+	_ resource.ResourceWithValidateConfig = &mappingRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &mappingRuleResource{}
+)
+
+// Mapping rule types. CSV rules enrich alerts from the rows of csv_data or
+// csv_file, topology rules from the matching service in the Keep topology.
+const (
+	mappingRuleTypeCSV      = "csv"
+	mappingRuleTypeTopology = "topology"
 )
 
 // NewMappingRuleResource is a helper function to simplify the provider implementation.
@@ -73,7 +88,7 @@ func parseCSVData(csvData string) ([]csvRow, error) {
 
 // mappingRuleResource defines the resource implementation.
 type mappingRuleResource struct {
-	client *client.Client
+	client *keep.Client
 }
 
 // mappingRuleResourceModel maps the resource schema data.
@@ -84,23 +99,22 @@ type mappingRuleResourceModel struct {
 	Priority    types.Int64  `tfsdk:"priority"`
 	Disabled    types.Bool   `tfsdk:"disabled"`
 	Matchers    types.Map    `tfsdk:"matchers"`
+	Type        types.String `tfsdk:"type"`
 	CSVData     types.String `tfsdk:"csv_data"`
+	CSVFile     types.String `tfsdk:"csv_file"`
+	CSVSHA256   types.String `tfsdk:"csv_sha256"`
+	RowsCount   types.Int64  `tfsdk:"rows_count"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 	LastUpdated types.String `tfsdk:"-"`
 }
 
-// matcher represents a single matcher in the API request
-type matcher struct {
-	Key   string `json:"key"`
-	Value string `json:"value"`
-}
-
 // Metadata returns the resource type name.
 func (r *mappingRuleResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_mapping_rule"
 }
 
 // Schema defines the schema for the resource.
-func (r *mappingRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *mappingRuleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a mapping rule in Keep. Mapping rules define how to enrich alerts with additional data from CSV files or topology data.",
 		Attributes: map[string]schema.Attribute{
@@ -108,6 +122,8 @@ func (r *mappingRuleResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Description: "The unique identifier of the mapping rule.",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
+					// Keep versions without in-place updates replace the rule,
+					// which changes its ID; Update warns when that happens
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
@@ -141,351 +157,303 @@ func (r *mappingRuleResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"type": schema.StringAttribute{
+				Description: "The type of the mapping rule: csv enriches alerts from csv_data or csv_file, topology from the matching topology service. Defaults to csv.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(mappingRuleTypeCSV),
+				Validators: []validator.String{
+					stringvalidator.OneOf(mappingRuleTypeCSV, mappingRuleTypeTopology),
+				},
+			},
 			"csv_data": schema.StringAttribute{
-				Description: "The CSV data to use for mapping. Each row should contain the matcher values and the fields to add to matching alerts.",
+				Description: "The CSV data to use for mapping. Each row should contain the matcher values and the fields to add to matching alerts. One of csv_data or csv_file is required for csv rules, and neither is allowed for topology rules.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"csv_file": schema.StringAttribute{
+				Description: "Path to a CSV file to use for mapping instead of csv_data. The file is read and parsed during plan, and only its SHA-256 and row count are kept in state.",
 				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("csv_data")),
+				},
+			},
+			"csv_sha256": schema.StringAttribute{
+				Description: "SHA-256 of the rows loaded from csv_file. Refreshed from the rows stored in Keep, so changes made outside Terraform show up as drift.",
 				Computed:    true,
 			},
+			"rows_count": schema.Int64Attribute{
+				Description: "The number of CSV rows of the mapping rule.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
 		},
 	}
 }
 
-// Configure adds the provider configured client to the resource.
-func (r *mappingRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
+// ValidateConfig requires csv_data or csv_file for csv rules and rejects both
+// for topology rules.
+func (r *mappingRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var ruleType, csvData, csvFile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &ruleType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("csv_data"), &csvData)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("csv_file"), &csvFile)...)
+	if resp.Diagnostics.HasError() || ruleType.IsUnknown() {
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
-	if !ok {
-		resp.Diagnostics.AddError(
-			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
-		)
-		return
+	switch ruleType.ValueString() {
+	case "", mappingRuleTypeCSV:
+		if csvData.IsNull() && csvFile.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("csv_data"),
+				"Missing CSV Data",
+				"One of csv_data or csv_file is required for mapping rules of type csv.",
+			)
+		}
+	case mappingRuleTypeTopology:
+		for name, value := range map[string]types.String{"csv_data": csvData, "csv_file": csvFile} {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name),
+					"Unexpected CSV Data",
+					name+" cannot be set for mapping rules of type topology, which enrich alerts from the Keep topology.",
+				)
+			}
+		}
 	}
-
-	r.client = client
 }
 
-// Create creates the resource and sets the initial Terraform state.
-func (r *mappingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	// Retrieve values from plan
-	var plan mappingRuleResourceModel
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+// ModifyPlan parses csv_file or csv_data so that rows_count, and csv_sha256
+// for rules loaded from csv_file, are known in the plan. Only the hash of the
+// file is planned, which keeps large files out of the state and plan output.
+func (r *mappingRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
 		return
 	}
 
-	// Convert matchers to the expected format (list of key-value pairs)
-	var matchersMap map[string]string
-	diags = plan.Matchers.ElementsAs(ctx, &matchersMap, false)
-	if diags.HasError() {
-		resp.Diagnostics.Append(diags...)
+	var plan mappingRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Convert map to list of matcher lists (e.g., [["key", "value"]])
-	matchersList := make([][]string, 0, len(matchersMap))
-	for key, value := range matchersMap {
-		matchersList = append(matchersList, []string{key, value})
-	}
-
-	// Initialize the rule with common fields
-	// Note: 'disabled' field is intentionally omitted as it's not supported by the API
-	rule := map[string]interface{}{
-		"name":        plan.Name.ValueString(),
-		"description": plan.Description.ValueString(),
-		"priority":    plan.Priority.ValueInt64(),
-		"matchers":    matchersList,
-	}
-
-	// Parse the CSV data if provided
-	var csvDataStr string
-	if !plan.CSVData.IsNull() && !plan.CSVData.IsUnknown() {
-		csvDataStr = plan.CSVData.ValueString()
-		tflog.Debug(ctx, "CSV data from plan", map[string]interface{}{
-			"raw_length": len(csvDataStr),
-			"raw_value":  getStringPreview(csvDataStr, 100),
-		})
-		
-		// Validate the CSV data
-		_, err := parseCSVData(csvDataStr)
+	switch {
+	case plan.Type.IsUnknown():
+		return
+	case plan.Type.ValueString() == mappingRuleTypeTopology:
+		plan.CSVData = types.StringNull()
+		plan.CSVSHA256 = types.StringNull()
+		plan.RowsCount = types.Int64Value(0)
+	case !plan.CSVFile.IsNull():
+		plan.CSVData = types.StringNull()
+		if plan.CSVFile.IsUnknown() {
+			plan.CSVSHA256 = types.StringUnknown()
+			plan.RowsCount = types.Int64Unknown()
+			break
+		}
+		rows, err := readCSVFile(plan.CSVFile.ValueString())
 		if err != nil {
-			tflog.Error(ctx, "Failed to parse CSV data", map[string]interface{}{
-				"error": err.Error(),
-			})
-			resp.Diagnostics.AddError(
-				"Invalid CSV Data",
-				fmt.Sprintf("Failed to parse CSV data: %s", err.Error()),
+			resp.Diagnostics.AddAttributeError(
+				path.Root("csv_file"),
+				"Invalid CSV File",
+				fmt.Sprintf("Could not load CSV file %s: %s", plan.CSVFile.ValueString(), err),
 			)
 			return
 		}
-	} else {
-		tflog.Debug(ctx, "No CSV data provided in plan")
-	}
-
-	// Handle CSV data if provided
-	if !plan.CSVData.IsNull() && !plan.CSVData.IsUnknown() {
-		csvData := plan.CSVData.ValueString()
-		rule["csv_data"] = csvData
-		rule["type"] = "csv"
-
-		// Parse CSV data to create rows
-		rows, err := parseCSVData(csvData)
+		plan.CSVSHA256 = types.StringValue(csvRowsHash(rows))
+		plan.RowsCount = types.Int64Value(int64(len(rows)))
+	case !plan.CSVData.IsNull() && !plan.CSVData.IsUnknown():
+		rows, err := parseCSVData(plan.CSVData.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(
-				"Error parsing CSV data",
-				"Could not parse CSV data: "+err.Error(),
+			resp.Diagnostics.AddAttributeError(
+				path.Root("csv_data"),
+				"Invalid CSV Data",
+				fmt.Sprintf("Failed to parse CSV data: %s", err),
 			)
 			return
 		}
-		rule["rows"] = rows
+		plan.CSVSHA256 = types.StringNull()
+		plan.RowsCount = types.Int64Value(int64(len(rows)))
+	default:
+		return
 	}
 
-	// Log the full request payload for debugging (without the potentially large CSV data for brevity)
-	loggableRule := make(map[string]interface{})
-	for k, v := range rule {
-		if k == "csv_data" {
-			csvStr, _ := v.(string)
-			loggableRule["csv_data"] = fmt.Sprintf("[CSV data, length: %d, preview: %s]", len(csvStr), getStringPreview(csvStr, 50))
-		} else if k == "rows" {
-			loggableRule["rows"] = "[CSV rows]"
-		} else {
-			loggableRule[k] = v
-		}
-	}
-	tflog.Debug(ctx, "Creating mapping rule - Request payload", map[string]interface{}{
-		"request": loggableRule,
-	})
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
 
-	// Log the mapping rule being created with field details
-	hasCSV := !plan.CSVData.IsNull() && !plan.CSVData.IsUnknown()
-	csvPreview := ""
-	if hasCSV {
-		csvPreview = getStringPreview(plan.CSVData.ValueString(), 50)
+// setRowsState sets rows_count, and csv_sha256 for rules loaded from
+// csv_file, from the rows of a mapping rule returned by the API.
+func (m *mappingRuleResourceModel) setRowsState(rule *keep.MappingRule) {
+	rows := apiMappingRuleRows(rule.Rows)
+	m.RowsCount = types.Int64Value(int64(len(rows)))
+	if m.CSVFile.IsNull() {
+		m.CSVSHA256 = types.StringNull()
+	} else {
+		m.CSVSHA256 = types.StringValue(csvRowsHash(rows))
 	}
+}
 
-	tflog.Debug(ctx, "Creating mapping rule - Field details", map[string]interface{}{
-		"id":           plan.ID.ValueString(),
-		"name":         plan.Name.ValueString(),
-		"description":  plan.Description.ValueString(),
-		"priority":     plan.Priority.ValueInt64(),
-		"disabled":     plan.Disabled.ValueBool(),
-		"matchers":     matchersList,
-		"has_csv":      hasCSV,
-		"csv_preview":  csvPreview,
-		"matchers_len": len(matchersList),
-	})
+// toClientRule converts the Terraform model to a keep.MappingRule, loading
+// the rows of csv rules from csv_data or csv_file.
+func (m *mappingRuleResourceModel) toClientRule(ctx context.Context) (keep.MappingRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-	// Create the mapping rule via API
-	createdRule, err := r.client.CreateMappingRule(ctx, rule)
-	if err != nil {
-		tflog.Error(ctx, "Failed to create mapping rule", map[string]interface{}{
-			"error": err.Error(),
-		})
-		resp.Diagnostics.AddError(
-			"Error creating mapping rule",
-			"Could not create mapping rule, unexpected error: "+err.Error(),
-		)
-		return
+	// Matchers are sent as a list of [key, value] pairs
+	matchers := keep.MappingRuleMatchers{}
+	if !m.Matchers.IsNull() && !m.Matchers.IsUnknown() {
+		diags.Append(m.Matchers.ElementsAs(ctx, &matchers, false)...)
 	}
 
-	// Log the API response with type information
-	responseTypes := make(map[string]string)
-	for k, v := range createdRule {
-		responseTypes[k] = fmt.Sprintf("%T", v)
+	// Note: 'disabled' field is intentionally omitted as it's not supported by the API
+	description := m.Description.ValueString()
+	rule := keep.MappingRule{
+		Name:        m.Name.ValueString(),
+		Description: &description,
+		Priority:    int(m.Priority.ValueInt64()),
+		Matchers:    matchers,
+		Type:        m.Type.ValueString(),
+	}
+	if rule.Type != mappingRuleTypeCSV {
+		return rule, diags
 	}
 
-	// Create a loggable version of the response (without large data)
-	loggableResponse := make(map[string]interface{})
-	for k, v := range createdRule {
-		if k == "csv_data" {
-			if csvStr, ok := v.(string); ok {
-				loggableResponse["csv_data"] = fmt.Sprintf("[CSV data, length: %d, preview: %s]", len(csvStr), getStringPreview(csvStr, 50))
-			} else {
-				loggableResponse["csv_data"] = fmt.Sprintf("[%T, value: %v]", v, v)
-			}
-		} else {
-			loggableResponse[k] = v
+	switch {
+	case !m.CSVData.IsNull() && !m.CSVData.IsUnknown():
+		csvData := m.CSVData.ValueString()
+		rows, err := parseCSVData(csvData)
+		if err != nil {
+			diags.AddError(
+				"Error parsing CSV data",
+				"Could not parse CSV data: "+err.Error(),
+			)
+			return rule, diags
 		}
+		rule.CSVData = &csvData
+		rule.Rows = clientMappingRuleRows(rows)
+	case !m.CSVFile.IsNull():
+		rows, err := readCSVFile(m.CSVFile.ValueString())
+		if err != nil {
+			diags.AddError(
+				"Error reading CSV file",
+				"Could not load CSV file: "+err.Error(),
+			)
+			return rule, diags
+		}
+		rule.Rows = clientMappingRuleRows(rows)
+		rule.FileName = filepath.Base(m.CSVFile.ValueString())
 	}
 
-	tflog.Debug(ctx, "Mapping rule created - API response", map[string]interface{}{
-		"response":      loggableResponse,
-		"response_types": responseTypes,
-	})
+	return rule, diags
+}
 
-	// Update the plan with the response
-	if id, ok := createdRule["id"]; ok {
-		plan.ID = types.StringValue(fmt.Sprint(id))
-		tflog.Debug(ctx, "Set ID from API response", map[string]interface{}{"id": id})
+// fromClientRule sets the model from a mapping rule returned by the API.
+// Values the API leaves out keep their current value.
+func (m *mappingRuleResourceModel) fromClientRule(rule *keep.MappingRule) {
+	if rule.ID != "" {
+		m.ID = types.StringValue(string(rule.ID))
 	}
-
-	if name, ok := createdRule["name"].(string); ok {
-		plan.Name = types.StringValue(name)
-		tflog.Debug(ctx, "Set name from API response", map[string]interface{}{"name": name})
+	if rule.Name != "" {
+		m.Name = types.StringValue(rule.Name)
 	}
-
-	if desc, ok := createdRule["description"].(string); ok {
-		plan.Description = types.StringValue(desc)
-		tflog.Debug(ctx, "Set description from API response", map[string]interface{}{"description": desc})
+	// Keep stores an unset description as an empty string
+	if rule.Description != nil && (*rule.Description != "" || !m.Description.IsNull()) {
+		m.Description = types.StringValue(*rule.Description)
 	}
-
-	if prio, ok := createdRule["priority"].(float64); ok {
-		plan.Priority = types.Int64Value(int64(prio))
-		tflog.Debug(ctx, "Set priority from API response", map[string]interface{}{"priority": prio})
+	m.Priority = types.Int64Value(int64(rule.Priority))
+	if rule.Disabled != nil {
+		m.Disabled = types.BoolValue(*rule.Disabled)
 	}
+	m.Matchers = flattenMappingRuleMatchers(rule.Matchers)
+	m.Type = mappingRuleType(rule.Type)
 
-	if disabled, ok := createdRule["disabled"].(bool); ok {
-		plan.Disabled = types.BoolValue(disabled)
-		tflog.Debug(ctx, "Set disabled from API response", map[string]interface{}{"disabled": disabled})
+	// Rules loaded from csv_file only track the hash of the server-side rows,
+	// which detects changes made outside Terraform.
+	switch {
+	case m.Type.ValueString() == mappingRuleTypeTopology:
+		m.CSVData = types.StringNull()
+	case rule.CSVData != nil && *rule.CSVData != "" && m.CSVFile.IsNull():
+		m.CSVData = types.StringValue(normalizeCSVData(*rule.CSVData))
 	}
+}
 
-	// Handle CSV data from API response
-	if csvData, exists := createdRule["csv_data"]; exists && csvData != nil {
-		if v, ok := csvData.(string); ok && v != "" {
-			normalizedCSV := strings.ReplaceAll(strings.TrimSpace(v), "\r\n", "\n")
-			plan.CSVData = types.StringValue(normalizedCSV)
-			tflog.Debug(ctx, "Set csv_data from API response in Create", map[string]interface{}{
-				"csv_data_length":  len(v),
-				"csv_data_preview": getStringPreview(v, 50),
-			})
-		} else {
-			// If API returns empty string, set to null
-			plan.CSVData = types.StringNull()
-			tflog.Debug(ctx, "Empty csv_data in API response in Create")
-		}
-	} else {
-		// If API omits csv_data, preserve value from plan
-		plan.CSVData = plan.CSVData
-		tflog.Debug(ctx, "No csv_data in API response, preserving value from plan")
+// Configure adds the provider configured client to the resource.
+func (r *mappingRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
 	}
 
-	// Handle matchers from API response
-	switch matchers := createdRule["matchers"].(type) {
-	case map[string]interface{}:
-		if len(matchers) > 0 {
-			matcherMap := make(map[string]attr.Value)
-			for k, v := range matchers {
-				switch v := v.(type) {
-				case string:
-					matcherMap[k] = types.StringValue(v)
-				case bool, float64:
-					matcherMap[k] = types.StringValue(fmt.Sprintf("%v", v))
-				default:
-					tflog.Warn(ctx, "Unsupported matcher value type", map[string]interface{}{
-						"key":   k,
-						"type":  fmt.Sprintf("%T", v),
-						"value": v,
-					})
-				}
-			}
-			mapValue, diags := types.MapValue(types.StringType, matcherMap)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				plan.Matchers = mapValue
-			}
-		}
-	case []interface{}:
-		matcherMap := make(map[string]attr.Value)
-		for _, m := range matchers {
-			if pair, ok := m.([]interface{}); ok && len(pair) == 2 {
-				if key, ok := pair[0].(string); ok {
-					matcherMap[key] = types.StringValue(fmt.Sprintf("%v", pair[1]))
-				}
-			}
-		}
-		mapValue, diags := types.MapValue(types.StringType, matcherMap)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			plan.Matchers = mapValue
-			tflog.Debug(ctx, "Set matchers from API response (list format)", map[string]interface{}{
-				"matchers_count": len(matcherMap),
-				"matchers_keys":  getMapKeys(matcherMap),
-			})
-		}
-	default:
-		plan.Matchers = types.MapNull(types.StringType)
-		tflog.Debug(ctx, "Unexpected matchers type in API response", map[string]interface{}{
-			"type":  fmt.Sprintf("%T", createdRule["matchers"]),
-			"value": createdRule["matchers"],
-		})
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
 	}
 
-	// Defensive: If matchers is still unknown or null, set as types.MapNull(types.StringType)
-	if plan.Matchers.IsUnknown() || plan.Matchers.IsNull() {
-		plan.Matchers = types.MapNull(types.StringType)
-	}
+	r.client = client
+}
 
-	// Map response body to model with proper type assertions
-	if id, ok := createdRule["id"]; ok {
-		switch v := id.(type) {
-		case float64:
-			plan.ID = types.StringValue(fmt.Sprintf("%.0f", v))
-		case string:
-			plan.ID = types.StringValue(v)
-		default:
-			tflog.Warn(ctx, "Unexpected type for ID in API response", map[string]interface{}{
-				"type": fmt.Sprintf("%T", id),
-			})
-		}
+// Create creates the resource and sets the initial Terraform state.
+func (r *mappingRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan mappingRuleResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if name, ok := createdRule["name"]; ok {
-		if nameStr, ok := name.(string); ok {
-			plan.Name = types.StringValue(nameStr)
-		}
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if desc, ok := createdRule["description"]; ok {
-		if descStr, ok := desc.(string); ok {
-			plan.Description = types.StringValue(descStr)
-		}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	rule, diags := plan.toClientRule(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if prio, ok := createdRule["priority"]; ok {
-		switch v := prio.(type) {
-		case float64:
-			plan.Priority = types.Int64Value(int64(v))
-		case int:
-			plan.Priority = types.Int64Value(int64(v))
-		case int64:
-			plan.Priority = types.Int64Value(v)
-		}
+	tflog.Debug(ctx, "Creating mapping rule", map[string]interface{}{
+		"name":     rule.Name,
+		"type":     rule.Type,
+		"matchers": len(rule.Matchers),
+		"rows":     len(rule.Rows),
+	})
+
+	// Create the mapping rule via API
+	createdRule, err := r.client.CreateMappingRule(ctx, rule)
+	if err != nil {
+		tflog.Error(ctx, "Failed to create mapping rule", map[string]interface{}{
+			"error": err.Error(),
+		})
+		resp.Diagnostics.AddError(
+			"Error creating mapping rule",
+			"Could not create mapping rule, unexpected error: "+err.Error(),
+		)
+		return
 	}
 
-	if disabled, ok := createdRule["disabled"]; ok {
-		if disabledBool, ok := disabled.(bool); ok {
-			plan.Disabled = types.BoolValue(disabledBool)
-		}
+	// Map response back to the plan
+	plan.fromClientRule(createdRule)
+
+	// Topology rules and rules loaded from csv_file have no CSV data
+	if plan.CSVData.IsUnknown() || !plan.CSVFile.IsNull() {
+		plan.CSVData = types.StringNull()
 	}
 
-	// Handle CSV data from API response
-	if csvData, exists := createdRule["csv_data"]; exists && csvData != nil {
-		switch v := csvData.(type) {
-		case string:
-			if v != "" {
-				// Normalize line endings and trim whitespace
-				normalizedCSV := strings.ReplaceAll(strings.TrimSpace(v), "\r\n", "\n")
-				plan.CSVData = types.StringValue(normalizedCSV)
-				tflog.Debug(ctx, "Set csv_data from API response in Create", map[string]interface{}{
-					"csv_data_length":  len(v),
-					"csv_data_preview": getStringPreview(v, 50),
-				})
-			} else {
-				plan.CSVData = types.StringNull()
-				tflog.Debug(ctx, "Empty csv_data in API response in Create")
-			}
-		default:
-			tflog.Debug(ctx, "Unexpected type for csv_data in API response", map[string]interface{}{
-				"type":  fmt.Sprintf("%T", csvData),
-				"value": fmt.Sprintf("%v", csvData),
-			})
-		}
-	} else {
-		tflog.Debug(ctx, "No csv_data in API response, preserving existing value")
+	// Row details are planned unless csv_data was unknown during plan
+	if plan.RowsCount.IsUnknown() || plan.CSVSHA256.IsUnknown() {
+		plan.setRowsState(createdRule)
 	}
 
 	// Set the last updated time
@@ -499,9 +467,9 @@ func (r *mappingRuleResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	tflog.Debug(ctx, "Successfully created mapping rule", map[string]interface{}{
-		"id":          plan.ID.ValueString(),
-		"name":        plan.Name.ValueString(),
-		"has_csv":     !plan.CSVData.IsNull() && !plan.CSVData.IsUnknown(),
+		"id":      plan.ID.ValueString(),
+		"name":    plan.Name.ValueString(),
+		"has_csv": !plan.CSVData.IsNull(),
 	})
 }
 
@@ -552,58 +520,39 @@ func (r *mappingRuleResource) Update(ctx context.Context, req resource.UpdateReq
 		"id": state.ID.ValueString(),
 	})
 
-	// Extract matchers from plan as a list of [key, value] pairs
-	var matchersList [][]string
-	if !plan.Matchers.IsNull() && !plan.Matchers.IsUnknown() {
-		var matchersMap map[string]string
-		diags = plan.Matchers.ElementsAs(ctx, &matchersMap, false)
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		for k, v := range matchersMap {
-			matchersList = append(matchersList, []string{k, v})
-		}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Prepare the update payload
-	// Note: 'disabled' field is intentionally omitted as it's not supported by the API
-	updateData := map[string]interface{}{
-		"name":        plan.Name.ValueString(),
-		"description": plan.Description.ValueString(),
-		"priority":    plan.Priority.ValueInt64(),
-		"matchers":    matchersList,
-	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
-	// Add CSV data and rows if provided
-	if !plan.CSVData.IsNull() && !plan.CSVData.IsUnknown() {
-		csvData := plan.CSVData.ValueString()
-		updateData["csv_data"] = csvData
-		
-		// Parse CSV data to rows for the API
-		if csvData != "" {
-			rows, err := parseCSVData(csvData)
-			if err != nil {
-				resp.Diagnostics.AddError(
-					"Error parsing CSV data",
-					fmt.Sprintf("Could not parse CSV data: %s", err.Error()),
-				)
-				return
-			}
-			updateData["rows"] = rows
-		}
+	rule, diags := plan.toClientRule(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Log the update data being sent to the API
 	tflog.Debug(ctx, "Sending update request to API", map[string]interface{}{
-		"id":          state.ID.ValueString(),
-		"update_data": updateData,
-		"disabled_in_plan": plan.Disabled.ValueBool(),
+		"id":       state.ID.ValueString(),
+		"name":     rule.Name,
+		"type":     rule.Type,
+		"matchers": len(rule.Matchers),
+		"rows":     len(rule.Rows),
 	})
 
 	// Update the mapping rule
-	updatedRule, err := r.client.UpdateMappingRule(ctx, state.ID.ValueString(), updateData)
-	if err != nil {
+	updatedRule, err := r.client.UpdateMappingRule(ctx, state.ID.ValueString(), rule)
+	var staleErr *keep.StaleMappingRuleError
+	if errors.As(err, &staleErr) {
+		// The replacement rule exists, so track it and leave the previous one to the user
+		resp.Diagnostics.AddWarning(
+			"Previous mapping rule not deleted",
+			fmt.Sprintf("The mapping rule was updated by creating a replacement rule, but the previous rule %s could not be deleted and must be removed manually: %s", staleErr.ID, staleErr.Err),
+		)
+	} else if err != nil {
 		errMsg := fmt.Sprintf("Could not update mapping rule, unexpected error: %s", err.Error())
 		tflog.Error(ctx, errMsg, map[string]interface{}{
 			"error": err,
@@ -615,196 +564,26 @@ func (r *mappingRuleResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	// Log the entire API response for debugging
-	tflog.Debug(ctx, "API response from UpdateMappingRule", map[string]interface{}{
-		"response": updatedRule,
-	})
-
-	tflog.Debug(ctx, "Received update response from API", map[string]interface{}{
-		"response": updatedRule,
-	})
-
-	// Update the plan with the response
-	if id, ok := updatedRule["id"].(string); ok {
-		plan.ID = types.StringValue(id)
-	} else {
-		tflog.Error(ctx, "Missing or invalid 'id' in API response", map[string]interface{}{
-			"type":  fmt.Sprintf("%T", updatedRule["id"]),
-			"value": updatedRule["id"],
-		})
-	}
-
-	if name, ok := updatedRule["name"].(string); ok {
-		plan.Name = types.StringValue(name)
-	} else {
-		tflog.Error(ctx, "Missing or invalid 'name' in API response", map[string]interface{}{
-			"type":  fmt.Sprintf("%T", updatedRule["name"]),
-			"value": updatedRule["name"],
-		})
-	}
-
-	if description, ok := updatedRule["description"].(string); ok {
-		plan.Description = types.StringValue(description)
-	} else {
-		tflog.Debug(ctx, "Missing or invalid 'description' in API response, using existing value")
-	}
-
-	if priority, ok := updatedRule["priority"].(float64); ok {
-		plan.Priority = types.Int64Value(int64(priority))
-	} else {
-		tflog.Error(ctx, "Missing or invalid 'priority' in API response", map[string]interface{}{
-			"type":  fmt.Sprintf("%T", updatedRule["priority"]),
-			"value": updatedRule["priority"],
-		})
-	}
-
-	// Save the disabled value from the plan before any updates
-	planDisabled := plan.Disabled
-	tflog.Debug(ctx, "Disabled value from plan before update", map[string]interface{}{
-		"disabled":      planDisabled.ValueBool(),
-		"disabled_type": fmt.Sprintf("%T", planDisabled),
-		"is_null":       planDisabled.IsNull(),
-		"is_unknown":    planDisabled.IsUnknown(),
-	})
-
-	// Handle the disabled field from the API response
-	if disabledVal, exists := updatedRule["disabled"]; exists {
-		tflog.Debug(ctx, "Disabled value from API response", map[string]interface{}{
-			"type":  fmt.Sprintf("%T", disabledVal),
-			"value": disabledVal,
-		})
-
-		switch v := disabledVal.(type) {
-		case bool:
-			plan.Disabled = types.BoolValue(v)
-			tflog.Debug(ctx, "Set disabled from API response (bool)", map[string]interface{}{
-				"disabled": v,
-			})
-		case string:
-			if v == "true" || v == "false" {
-				disabled, _ := strconv.ParseBool(v)
-				plan.Disabled = types.BoolValue(disabled)
-				tflog.Debug(ctx, "Set disabled from API response (string)", map[string]interface{}{
-					"disabled": disabled,
-				})
-			} else {
-				tflog.Debug(ctx, "Invalid 'disabled' string value in API response, using plan value", map[string]interface{}{
-					"value": v,
-				})
-				plan.Disabled = planDisabled
-			}
-		default:
-			tflog.Debug(ctx, "Unexpected 'disabled' type in API response, using plan value", map[string]interface{}{
-				"type":  fmt.Sprintf("%T", v),
-				"value": v,
-			})
-			plan.Disabled = planDisabled
-		}
-	} else {
-		tflog.Debug(ctx, "'disabled' field not in API response, using plan value", map[string]interface{}{
-			"plan_disabled": planDisabled.ValueBool(),
-		})
-		plan.Disabled = planDisabled
-	}
-
-	// Log the final disabled value that will be set in the state
-	tflog.Debug(ctx, "Final disabled value for state", map[string]interface{}{
-		"disabled": plan.Disabled.ValueBool(),
-		"disabled_type": fmt.Sprintf("%T", plan.Disabled),
-	})
-
-	// Log the entire plan before setting state
-	tflog.Debug(ctx, "Plan before setting state", map[string]interface{}{
-		"id":          plan.ID.ValueString(),
-		"name":        plan.Name.ValueString(),
-		"description": plan.Description.ValueString(),
-		"priority":    plan.Priority.ValueInt64(),
-		"disabled":    plan.Disabled.ValueBool(),
-		"has_csv":     !plan.CSVData.IsNull() && !plan.CSVData.IsUnknown(),
-		"has_matchers": !plan.Matchers.IsNull() && !plan.Matchers.IsUnknown(),
-	})
-
-	// Handle matchers from response
-	matcherMap := make(map[string]attr.Value)
-
-	switch matchers := updatedRule["matchers"].(type) {
-	case map[string]interface{}:
-		// Handle map format
-		for k, v := range matchers {
-			switch v := v.(type) {
-			case string:
-				matcherMap[k] = types.StringValue(v)
-			case float64:
-				// Convert float64 to string, handling potential integer values
-				if v == float64(int64(v)) {
-					matcherMap[k] = types.StringValue(fmt.Sprintf("%d", int64(v)))
-				} else {
-					matcherMap[k] = types.StringValue(fmt.Sprintf("%v", v))
-				}
-			default:
-				// Fallback for any other type
-				matcherMap[k] = types.StringValue(fmt.Sprintf("%v", v))
-			}
-		}
-
-	case []interface{}:
-		// Handle list of [key, value] pairs format
-		for _, m := range matchers {
-			if pair, ok := m.([]interface{}); ok && len(pair) == 2 {
-				key, keyOk := pair[0].(string)
-				if !keyOk {
-					continue
-				}
-
-				switch v := pair[1].(type) {
-				case string:
-					matcherMap[key] = types.StringValue(v)
-				case float64:
-					// Convert float64 to string, handling potential integer values
-					if v == float64(int64(v)) {
-						matcherMap[key] = types.StringValue(fmt.Sprintf("%d", int64(v)))
-					} else {
-						matcherMap[key] = types.StringValue(fmt.Sprintf("%v", v))
-					}
-				default:
-					// Fallback for any other type
-					matcherMap[key] = types.StringValue(fmt.Sprintf("%v", v))
-				}
-			}
-		}
-
-	default:
-		tflog.Debug(ctx, "Unexpected matchers type in API response", map[string]interface{}{
-			"type":  fmt.Sprintf("%T", updatedRule["matchers"]),
-			"value": updatedRule["matchers"],
-		})
-	}
-
-	// Set the matchers in the plan
-	if len(matcherMap) > 0 {
-		mapValue, diags := types.MapValue(types.StringType, matcherMap)
-		if !diags.HasError() {
-			plan.Matchers = mapValue
-		} else {
-			tflog.Debug(ctx, "Failed to create map value for matchers", map[string]interface{}{
-				"error": diags.Errors(),
-			})
-			plan.Matchers = types.MapNull(types.StringType)
-		}
-	} else {
-		plan.Matchers = types.MapNull(types.StringType)
+	// Update the plan with the response. The ID changes when the rule was replaced.
+	if updatedRule.ID == "" {
+		tflog.Error(ctx, "Missing 'id' in API response, keeping the previous ID")
+		plan.ID = state.ID
+	} else if string(updatedRule.ID) != state.ID.ValueString() {
+		resp.Diagnostics.AddWarning(
+			"Mapping rule replaced",
+			fmt.Sprintf("This Keep version does not update mapping rules in place, so rule %s was replaced by rule %s.", state.ID.ValueString(), updatedRule.ID),
+		)
 	}
+	plan.fromClientRule(updatedRule)
 
-	// Defensive: If matchers is still unknown or null, set as types.MapNull(types.StringType)
-	if plan.Matchers.IsUnknown() || plan.Matchers.IsNull() {
-		plan.Matchers = types.MapNull(types.StringType)
+	// Topology rules and rules loaded from csv_file have no CSV data
+	if plan.CSVData.IsUnknown() || !plan.CSVFile.IsNull() {
+		plan.CSVData = types.StringNull()
 	}
 
-	// Update CSV data from response if present
-	if csvData, ok := updatedRule["csv_data"].(string); ok && csvData != "" {
-		// Normalize line endings and trim whitespace
-		normalizedCSV := strings.ReplaceAll(strings.TrimSpace(csvData), "\r\n", "\n")
-		plan.CSVData = types.StringValue(normalizedCSV)
+	// Row details are planned unless csv_data was unknown during plan
+	if plan.RowsCount.IsUnknown() || plan.CSVSHA256.IsUnknown() {
+		plan.setRowsState(updatedRule)
 	}
 
 	// Set the last updated time
@@ -818,9 +597,9 @@ func (r *mappingRuleResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	tflog.Debug(ctx, "Updated mapping rule", map[string]interface{}{
-		"id":          plan.ID.ValueString(),
-		"name":        plan.Name.ValueString(),
-		"has_csv":     !plan.CSVData.IsNull() && !plan.CSVData.IsUnknown(),
+		"id":      plan.ID.ValueString(),
+		"name":    plan.Name.ValueString(),
+		"has_csv": !plan.CSVData.IsNull(),
 	})
 }
 
@@ -834,9 +613,22 @@ func (r *mappingRuleResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get refreshed mapping rule from API
 	rule, err := r.client.GetMappingRule(ctx, state.ID.ValueString())
 	if err != nil {
+		if keep.IsNotFound(err) {
+			removeDeletedResource(ctx, resp, "mapping rule", state.ID.ValueString())
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error reading mapping rule",
 			fmt.Sprintf("Could not read mapping rule ID %s: %s", state.ID.ValueString(), err.Error()),
@@ -845,65 +637,8 @@ func (r *mappingRuleResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	// Overwrite state with refreshed values
-	if name, ok := rule["name"].(string); ok {
-		state.Name = types.StringValue(name)
-	}
-
-	if desc, ok := rule["description"].(string); ok {
-		state.Description = types.StringValue(desc)
-	}
-
-	if prio, ok := rule["priority"].(float64); ok {
-		state.Priority = types.Int64Value(int64(prio))
-	}
-
-	if disabled, ok := rule["disabled"].(bool); ok {
-		state.Disabled = types.BoolValue(disabled)
-	}
-
-	// Handle matchers
-	switch matchers := rule["matchers"].(type) {
-	case map[string]interface{}:
-		matcherMap := make(map[string]attr.Value)
-		for k, v := range matchers {
-			matcherMap[k] = types.StringValue(fmt.Sprintf("%v", v))
-		}
-		mapValue, diags := types.MapValue(types.StringType, matcherMap)
-		if !diags.HasError() {
-			state.Matchers = mapValue
-		}
-	case []interface{}:
-		matcherMap := make(map[string]attr.Value)
-		for _, m := range matchers {
-			if pair, ok := m.([]interface{}); ok && len(pair) == 2 {
-				if key, ok := pair[0].(string); ok {
-					matcherMap[key] = types.StringValue(fmt.Sprintf("%v", pair[1]))
-				}
-			}
-		}
-		mapValue, diags := types.MapValue(types.StringType, matcherMap)
-		if !diags.HasError() {
-			state.Matchers = mapValue
-		}
-	default:
-		state.Matchers = types.MapNull(types.StringType)
-		tflog.Debug(ctx, "Unexpected matchers type in API response", map[string]interface{}{
-			"type":  fmt.Sprintf("%T", rule["matchers"]),
-			"value": rule["matchers"],
-		})
-	}
-
-	// Defensive: If matchers is still unknown or null, set as types.MapNull(types.StringType)
-	if state.Matchers.IsUnknown() || state.Matchers.IsNull() {
-		state.Matchers = types.MapNull(types.StringType)
-	}
-
-	// Handle CSV data
-	if csvData, ok := rule["csv_data"].(string); ok && csvData != "" {
-		// Normalize line endings and trim whitespace
-		normalizedCSV := strings.ReplaceAll(strings.TrimSpace(csvData), "\r\n", "\n")
-		state.CSVData = types.StringValue(normalizedCSV)
-	}
+	state.fromClientRule(rule)
+	state.setRowsState(rule)
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -936,78 +671,22 @@ func (r *mappingRuleResource) ImportState(ctx context.Context, req resource.Impo
 		return
 	}
 
-	tflog.Debug(ctx, "Retrieved mapping rule from API", map[string]interface{}{
-		"rule_data": rule,
-	})
-
-	// Create a new state model
+	// Create a new state model, keeping the null timeouts block from the import state
 	var state mappingRuleResourceModel
-
-	// Set the ID from the import ID
-	state.ID = types.StringValue(req.ID)
-
-	// Set other fields from the API response
-	if name, ok := rule["name"].(string); ok {
-		state.Name = types.StringValue(name)
-	}
-
-	if desc, ok := rule["description"].(string); ok {
-		state.Description = types.StringValue(desc)
-	}
-
-	if prio, ok := rule["priority"].(float64); ok {
-		state.Priority = types.Int64Value(int64(prio))
-	}
-
-	// Handle disabled field - default to false if not set
-	disabled := false
-	if d, ok := rule["disabled"].(bool); ok {
-		disabled = d
-	}
-	state.Disabled = types.BoolValue(disabled)
-
-	// Handle matchers
-	matcherMap := make(map[string]attr.Value)
-	if matchers, exists := rule["matchers"]; exists && matchers != nil {
-		switch m := matchers.(type) {
-		case map[string]interface{}:
-			for k, v := range m {
-				matcherMap[k] = types.StringValue(fmt.Sprintf("%v", v))
-			}
-		case []interface{}:
-			for _, item := range m {
-				if pair, ok := item.([]interface{}); ok && len(pair) == 2 {
-					if key, ok := pair[0].(string); ok {
-						matcherMap[key] = types.StringValue(fmt.Sprintf("%v", pair[1]))
-					}
-				}
-			}
-		}
-	}
-
-	// Set matchers in state, or null if empty
-	if len(matcherMap) > 0 {
-		mapValue, diags := types.MapValue(types.StringType, matcherMap)
-		if !diags.HasError() {
-			state.Matchers = mapValue
-		} else {
-			state.Matchers = types.MapNull(types.StringType)
-		}
-	} else {
-		state.Matchers = types.MapNull(types.StringType)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &state.Timeouts)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	// Handle CSV data - ensure consistent formatting
-	if csvData, ok := rule["csv_data"].(string); ok && csvData != "" {
-		// Normalize line endings, trim whitespace, and ensure consistent line endings
-		normalizedCSV := strings.TrimSpace(csvData)
-		normalizedCSV = strings.ReplaceAll(normalizedCSV, "\r\n", "\n")
-		normalizedCSV = strings.ReplaceAll(normalizedCSV, "\r", "\n")
-		state.CSVData = types.StringValue(normalizedCSV)
-	} else {
-		// Explicitly set to null if not present
-		state.CSVData = types.StringNull()
+	// Set the ID from the import ID, and other fields from the API response.
+	// csv_file cannot be recovered from the API.
+	state.ID = types.StringValue(req.ID)
+	state.CSVFile = types.StringNull()
+	state.fromClientRule(rule)
+	if state.Disabled.IsNull() {
+		state.Disabled = types.BoolValue(false)
 	}
+	state.setRowsState(rule)
 
 	// Set the last updated time
 	state.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
@@ -1,34 +1,90 @@
 package provider
 
 import (
-	"fmt"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/keephq/terraform-provider-keep/keep"
 )
 
-// getStringPreview returns a preview of a string with the specified max length
-func getStringPreview(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
+// flattenMappingRuleMatchers converts the matchers of an API mapping rule to
+// a map. Rules returned without matchers yield a null map.
+func flattenMappingRuleMatchers(matchers keep.MappingRuleMatchers) types.Map {
+	if matchers == nil {
+		return types.MapNull(types.StringType)
+	}
+
+	matcherMap := make(map[string]attr.Value, len(matchers))
+	for k, v := range matchers {
+		matcherMap[k] = types.StringValue(v)
 	}
-	return s[:maxLen] + "..."
+	mapValue, diags := types.MapValue(types.StringType, matcherMap)
+	if diags.HasError() {
+		return types.MapNull(types.StringType)
+	}
+	return mapValue
 }
 
-// getMapKeys returns a slice of keys from a map
-func getMapKeys(m interface{}) []string {
-	switch v := m.(type) {
-	case map[string]interface{}:
-		keys := make([]string, 0, len(v))
-		for k := range v {
-			keys = append(keys, k)
-		}
-		return keys
-	case map[string]attr.Value:
-		keys := make([]string, 0, len(v))
-		for k := range v {
-			keys = append(keys, k)
+// mappingRuleType returns the type of an API mapping rule. Rules created before
+// Keep supported topology rules have no type and are csv rules.
+func mappingRuleType(ruleType string) types.String {
+	if ruleType != "" {
+		return types.StringValue(ruleType)
+	}
+	return types.StringValue(mappingRuleTypeCSV)
+}
+
+// csvRowsHash returns the hex encoded SHA-256 of mapping rule rows. Rows are
+// hashed in order as JSON objects with sorted keys, so rows parsed from a CSV
+// file and rows returned by the API hash the same when they hold the same data.
+func csvRowsHash(rows []csvRow) string {
+	if rows == nil {
+		rows = []csvRow{}
+	}
+	// Marshaling maps of strings cannot fail
+	data, _ := json.Marshal(rows)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// apiMappingRuleRows converts the rows of an API mapping rule to CSV rows.
+func apiMappingRuleRows(rows []keep.StringMap) []csvRow {
+	result := make([]csvRow, 0, len(rows))
+	for _, row := range rows {
+		if row == nil {
+			row = keep.StringMap{}
 		}
-		return keys
-	default:
-		return []string{fmt.Sprintf("unsupported type: %T", m)}
+		result = append(result, csvRow(row))
+	}
+	return result
+}
+
+// clientMappingRuleRows converts parsed CSV rows to the rows of an API mapping rule.
+func clientMappingRuleRows(rows []csvRow) []keep.StringMap {
+	result := make([]keep.StringMap, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, keep.StringMap(row))
+	}
+	return result
+}
+
+// normalizeCSVData trims CSV data returned by the API and normalizes its line
+// endings, so it compares equal to the configured csv_data.
+func normalizeCSVData(csvData string) string {
+	csvData = strings.ReplaceAll(strings.TrimSpace(csvData), "\r\n", "\n")
+	return strings.ReplaceAll(csvData, "\r", "\n")
+}
+
+// readCSVFile reads and parses a mapping rule CSV file.
+func readCSVFile(path string) ([]csvRow, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
 	}
+	return parseCSVData(string(data))
 }
@@ -3,15 +3,18 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
-	"github.com/keephq/terraform-provider-keep/internal/client"
+	"github.com/keephq/terraform-provider-keep/keep"
 )
 
 // testAccCheckMappingRuleExists checks if a mapping rule exists
@@ -33,7 +36,7 @@ func testAccCheckMappingRuleExists(n string) resource.TestCheckFunc {
 			apiURL = "http://localhost:8080"
 		}
 
-		c, err := client.NewClient(apiURL, apiKey)
+		c, err := keep.NewClient(apiURL, apiKey)
 		if err != nil {
 			return fmt.Errorf("failed to create client: %w", err)
 		}
@@ -89,7 +92,7 @@ func testAccCheckMappingRuleDestroy(s *terraform.State) error {
 		apiURL = "http://localhost:8080"
 	}
 
-	client, err := client.NewClient(apiURL, apiKey)
+	c, err := keep.NewClient(apiURL, apiKey)
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
@@ -100,21 +103,60 @@ func testAccCheckMappingRuleDestroy(s *terraform.State) error {
 		}
 
 		// Try to find the mapping rule
-		_, err := client.GetMappingRule(context.Background(), rs.Primary.ID)
+		_, err := c.GetMappingRule(context.Background(), rs.Primary.ID)
 		if err == nil {
 			return fmt.Errorf("mapping rule %s still exists", rs.Primary.ID)
 		}
 
 		// Verify the error is because the rule doesn't exist
-		errMsg := err.Error()
-		if matched, _ := regexp.MatchString("mapping rule with ID .* not found", errMsg); !matched {
-			return fmt.Errorf("unexpected error: %s", errMsg)
+		if !keep.IsNotFound(err) {
+			return fmt.Errorf("unexpected error: %s", err)
 		}
 	}
 
 	return nil
 }
 
+func TestCSVRowsHash(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "cmdb.csv")
+	csvData := "service, owner ,tier\r\napi,alice,1\nbilling,bob, 2\n"
+	if err := os.WriteFile(file, []byte(csvData), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	fileRows, err := readCSVFile(file)
+	if err != nil {
+		t.Fatalf("readCSVFile() error = %v", err)
+	}
+	if len(fileRows) != 2 {
+		t.Fatalf("readCSVFile() returned %d rows, want 2", len(fileRows))
+	}
+
+	// Rows as stored and returned by the API
+	var rule keep.MappingRule
+	body := `{"rows": [{"tier": "1", "service": "api", "owner": "alice"}, {"owner": "bob", "service": "billing", "tier": 2}]}`
+	if err := json.Unmarshal([]byte(body), &rule); err != nil {
+		t.Fatal(err)
+	}
+	apiRows := apiMappingRuleRows(rule.Rows)
+	if got, want := csvRowsHash(apiRows), csvRowsHash(fileRows); got != want {
+		t.Errorf("csvRowsHash() of API rows = %s, want %s", got, want)
+	}
+
+	// Any change to the server-side rows is drift
+	apiRows[1]["owner"] = "carol"
+	if csvRowsHash(apiRows) == csvRowsHash(fileRows) {
+		t.Error("csvRowsHash() did not change after a row was edited")
+	}
+
+	if rows := apiMappingRuleRows(nil); len(rows) != 0 {
+		t.Errorf("apiMappingRuleRows(nil) = %v, want no rows", rows)
+	}
+	if csvRowsHash(nil) != csvRowsHash([]csvRow{}) {
+		t.Error("csvRowsHash() differs for nil and empty rows")
+	}
+}
+
 func TestAccMappingRuleResource(t *testing.T) {
 	if testing.Short() {
 		t.Skip("Skipping acceptance test in short mode")
@@ -245,3 +287,181 @@ staging,dev,bob
 }
 `, os.Getenv("KEEP_API_KEY"), os.Getenv("KEEP_API_URL"), name, description, priority)
 }
+
+func TestAccMappingRuleResourceTopology(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMappingRuleDestroy,
+		Steps: []resource.TestStep{
+			// Topology rules take their data from the topology, not from CSV
+			{
+				Config:      testAccMappingRuleResourceTopology("csv_data = \"service,owner\\napi,sre\""),
+				ExpectError: regexp.MustCompile(`Unexpected CSV Data`),
+			},
+			// CSV rules need CSV data
+			{
+				Config:      strings.Replace(testAccMappingRuleResourceTopology(""), `"topology"`, `"csv"`, 1),
+				ExpectError: regexp.MustCompile(`Missing CSV Data`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccMappingRuleResourceTopology(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMappingRuleExists("keep_mapping_rule.test"),
+					resource.TestCheckResourceAttr("keep_mapping_rule.test", "type", "topology"),
+					resource.TestCheckNoResourceAttr("keep_mapping_rule.test", "csv_data"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "keep_mapping_rule.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+		},
+	})
+}
+
+// testAccMappingRuleResourceTopology returns the configuration for a topology mapping rule
+func testAccMappingRuleResourceTopology(extra string) string {
+	return fmt.Sprintf(`
+provider "keep" {
+  api_key = "%s"
+  api_url = "%s"
+}
+
+resource "keep_mapping_rule" "test" {
+  name     = "tf-acc-topology-mapping-rule"
+  type     = "topology"
+  matchers = {
+    service = "service"
+  }
+  %s
+}
+`, os.Getenv("KEEP_API_KEY"), os.Getenv("KEEP_API_URL"), extra)
+}
+
+func TestAccMappingRuleResourceCSVFile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	file := filepath.Join(t.TempDir(), "owners.csv")
+	writeCSV := func(data string) {
+		if err := os.WriteFile(file, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeCSV("service,owner\napi,alice\nbilling,bob\n")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckMappingRuleDestroy,
+		Steps: []resource.TestStep{
+			// csv_data and csv_file are mutually exclusive
+			{
+				Config:      testAccMappingRuleResourceCSVFile(file, "csv_data = \"service,owner\\napi,sre\""),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccMappingRuleResourceCSVFile(file, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMappingRuleExists("keep_mapping_rule.test"),
+					resource.TestCheckResourceAttr("keep_mapping_rule.test", "rows_count", "2"),
+					resource.TestCheckResourceAttrSet("keep_mapping_rule.test", "csv_sha256"),
+					resource.TestCheckNoResourceAttr("keep_mapping_rule.test", "csv_data"),
+				),
+			},
+			// Editing the file updates the rule
+			{
+				PreConfig: func() { writeCSV("service,owner\napi,alice\nbilling,bob\nsearch,carol\n") },
+				Config:    testAccMappingRuleResourceCSVFile(file, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("keep_mapping_rule.test", "rows_count", "3"),
+				),
+			},
+		},
+	})
+}
+
+// testAccMappingRuleResourceCSVFile returns the configuration for a mapping rule loaded from a CSV file
+func testAccMappingRuleResourceCSVFile(file, extra string) string {
+	return fmt.Sprintf(`
+provider "keep" {
+  api_key = "%s"
+  api_url = "%s"
+}
+
+resource "keep_mapping_rule" "test" {
+  name     = "tf-acc-csv-file-mapping-rule"
+  csv_file = %q
+  matchers = {
+    service = "service"
+  }
+  %s
+}
+`, os.Getenv("KEEP_API_KEY"), os.Getenv("KEEP_API_URL"), file, extra)
+}
+
+func TestMappingRuleFromClientRule(t *testing.T) {
+	var rule keep.MappingRule
+	body := `{"id": 12, "name": "cmdb", "description": "", "priority": 1, "matchers": [["service", "api"]], "csv_data": "service,owner\r\napi,alice\r\n", "rows": [{"service": "api", "owner": "alice"}]}`
+	if err := json.Unmarshal([]byte(body), &rule); err != nil {
+		t.Fatal(err)
+	}
+
+	m := mappingRuleResourceModel{
+		Description: types.StringNull(),
+		Disabled:    types.BoolValue(false),
+		CSVFile:     types.StringNull(),
+	}
+	m.fromClientRule(&rule)
+	m.setRowsState(&rule)
+
+	if m.ID.ValueString() != "12" || m.Name.ValueString() != "cmdb" || m.Priority.ValueInt64() != 1 {
+		t.Errorf("unexpected rule fields: %+v", m)
+	}
+	if !m.Description.IsNull() {
+		t.Errorf("expected an empty description to stay null, got %s", m.Description)
+	}
+	if m.Disabled.ValueBool() {
+		t.Error("expected disabled to keep its value when the API omits it")
+	}
+	if got := m.Matchers.Elements()["service"]; !got.Equal(types.StringValue("api")) {
+		t.Errorf("expected matcher service=api, got %v", m.Matchers)
+	}
+	if m.Type.ValueString() != mappingRuleTypeCSV {
+		t.Errorf("expected rules without a type to be csv rules, got %s", m.Type)
+	}
+	if want := "service,owner\napi,alice"; m.CSVData.ValueString() != want {
+		t.Errorf("expected csv_data %q, got %q", want, m.CSVData.ValueString())
+	}
+	if m.RowsCount.ValueInt64() != 1 || !m.CSVSHA256.IsNull() {
+		t.Errorf("unexpected row details: rows_count %s, csv_sha256 %s", m.RowsCount, m.CSVSHA256)
+	}
+
+	// Topology rules have no CSV data, whatever the API returns
+	rule.Type = mappingRuleTypeTopology
+	m.fromClientRule(&rule)
+	if !m.CSVData.IsNull() {
+		t.Errorf("expected null csv_data for a topology rule, got %s", m.CSVData)
+	}
+}
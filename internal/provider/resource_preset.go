@@ -0,0 +1,472 @@
+// resource_preset.go - Resource implementation for KeepHQ presets
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &presetResource{}
+	_ resource.ResourceWithConfigure   = &presetResource{}
+	_ resource.ResourceWithImportState = &presetResource{}
+)
+
+// Labels of the preset options managed by the resource. Options with other
+// labels, such as those saved by the Keep UI, are left as they are.
+const (
+	presetOptionCEL     = "CEL"
+	presetOptionSQL     = "SQL"
+	presetOptionGroupBy = "group_by"
+)
+
+// NewPresetResource is a helper function to simplify the provider implementation.
+func NewPresetResource() resource.Resource {
+	return &presetResource{}
+}
+
+// presetResource defines the resource implementation.
+type presetResource struct {
+	client *keep.Client
+}
+
+// presetResourceModel maps the resource schema data.
+type presetResourceModel struct {
+	ID                     types.String   `tfsdk:"id"`
+	Name                   types.String   `tfsdk:"name"`
+	CELQuery               celExpression  `tfsdk:"cel_query"`
+	SQLQuery               types.String   `tfsdk:"sql_query"`
+	IsPrivate              types.Bool     `tfsdk:"is_private"`
+	IsNoisy                types.Bool     `tfsdk:"is_noisy"`
+	ShouldDoNoiseNow       types.Bool     `tfsdk:"should_do_noise_now"`
+	CounterShowsFiringOnly types.Bool     `tfsdk:"counter_shows_firing_only"`
+	GroupBy                types.String   `tfsdk:"group_by"`
+	Tags                   types.Set      `tfsdk:"tags"`
+	CreatedBy              types.String   `tfsdk:"created_by"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+}
+
+// presetSQL returns the SQL query of a preset SQL option, which the API
+// stores as an object with the query and its parameters.
+func presetSQL(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case map[string]interface{}:
+		if sql, ok := v["sql"].(string); ok {
+			return sql
+		}
+	}
+	return ""
+}
+
+// presetString returns the value of a string preset option, or an empty string.
+func presetString(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return ""
+}
+
+// presetOptions builds the options of a preset from the managed values,
+// keeping existing options with other labels.
+func presetOptions(cel, sql, groupBy string, existing []keep.PresetOption) []keep.PresetOption {
+	options := []keep.PresetOption{{Label: presetOptionCEL, Value: cel}}
+	if sql != "" {
+		options = append(options, keep.PresetOption{
+			Label: presetOptionSQL,
+			Value: map[string]interface{}{"sql": sql, "params": map[string]interface{}{}},
+		})
+	}
+	if groupBy != "" {
+		options = append(options, keep.PresetOption{Label: presetOptionGroupBy, Value: groupBy})
+	}
+
+	for _, option := range existing {
+		switch option.Label {
+		case presetOptionCEL, presetOptionSQL, presetOptionGroupBy:
+		default:
+			options = append(options, option)
+		}
+	}
+
+	return options
+}
+
+// toClientRequest converts the Terraform model to a keep.PresetRequest
+func (m *presetResourceModel) toClientRequest(ctx context.Context, existing []keep.PresetOption) (keep.PresetRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var tagNames []string
+	if !m.Tags.IsNull() {
+		diags.Append(m.Tags.ElementsAs(ctx, &tagNames, false)...)
+	}
+	sort.Strings(tagNames)
+
+	tags := make([]keep.PresetTag, 0, len(tagNames))
+	for _, name := range tagNames {
+		tags = append(tags, keep.PresetTag{Name: name})
+	}
+
+	return keep.PresetRequest{
+		Name:                   m.Name.ValueString(),
+		Options:                presetOptions(m.CELQuery.ValueString(), m.SQLQuery.ValueString(), m.GroupBy.ValueString(), existing),
+		IsPrivate:              m.IsPrivate.ValueBool(),
+		IsNoisy:                m.IsNoisy.ValueBool(),
+		CounterShowsFiringOnly: m.CounterShowsFiringOnly.ValueBool(),
+		Tags:                   tags,
+	}, diags
+}
+
+// fromClientPreset sets the model from a preset returned by the API
+func (m *presetResourceModel) fromClientPreset(ctx context.Context, preset *keep.Preset) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(preset.ID)
+	m.Name = types.StringValue(preset.Name)
+	m.CELQuery = newCELExpressionValue(presetString(preset.Option(presetOptionCEL)))
+	m.IsPrivate = types.BoolValue(preset.IsPrivate)
+	m.IsNoisy = types.BoolValue(preset.IsNoisy)
+	m.ShouldDoNoiseNow = types.BoolValue(preset.ShouldDoNoiseNow)
+	m.CounterShowsFiringOnly = types.BoolValue(preset.CounterShowsFiringOnly)
+	m.GroupBy = optionalString(presetString(preset.Option(presetOptionGroupBy)))
+	m.CreatedBy = optionalString(preset.CreatedBy)
+
+	// The Keep UI saves a SQL form of every query it edits, so the SQL
+	// query is only tracked once it is configured
+	if !m.SQLQuery.IsNull() {
+		m.SQLQuery = types.StringValue(presetSQL(preset.Option(presetOptionSQL)))
+	}
+
+	// No tags matches an unset tags attribute
+	if len(preset.Tags) > 0 || !m.Tags.IsNull() {
+		names := make([]string, 0, len(preset.Tags))
+		for _, tag := range preset.Tags {
+			names = append(names, tag.Name)
+		}
+		var d diag.Diagnostics
+		m.Tags, d = types.SetValueFrom(ctx, types.StringType, names)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// Metadata returns the resource type name.
+func (r *presetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_preset"
+}
+
+// Schema defines the schema for the resource.
+func (r *presetResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a preset in Keep. Presets are saved alert views selecting alerts with a CEL query.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the preset.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the preset, unique within the tenant.",
+				Required:    true,
+			},
+			"cel_query": schema.StringAttribute{
+				Description: "CEL expression selecting the alerts shown by the preset, evaluated against the alert fields. It is parsed when the configuration is validated.",
+				Required:    true,
+				CustomType:  celExpressionType{},
+			},
+			"sql_query": schema.StringAttribute{
+				Description: "SQL form of the query, saved alongside cel_query. Only tracked for drift when set.",
+				Optional:    true,
+			},
+			"is_private": schema.BoolAttribute{
+				Description: "Whether the preset is only visible to its creator. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"is_noisy": schema.BoolAttribute{
+				Description: "Whether Keep plays a sound while the preset has firing alerts. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"should_do_noise_now": schema.BoolAttribute{
+				Description: "Whether the preset is noisy and currently has firing alerts.",
+				Computed:    true,
+			},
+			"counter_shows_firing_only": schema.BoolAttribute{
+				Description: "Whether the alert counter of the preset only counts firing alerts. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"group_by": schema.StringAttribute{
+				Description: "Alert field the preset groups alerts by, such as \"service\".",
+				Optional:    true,
+			},
+			"tags": schema.SetAttribute{
+				Description: "Names of the tags attached to the preset. Tags that do not exist yet are created.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"created_by": schema.StringAttribute{
+				Description: "Who created the preset.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *presetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *presetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan presetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	presetReq, diags := plan.toClientRequest(ctx, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the preset via API
+	preset, err := r.client.CreatePreset(ctx, presetReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating preset",
+			"Could not create preset, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Created preset", map[string]interface{}{
+		"id":   preset.ID,
+		"name": preset.Name,
+	})
+
+	// Map response back to the plan
+	resp.Diagnostics.Append(plan.fromClientPreset(ctx, preset)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *presetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state presetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	presetID := state.ID.ValueString()
+	preset, err := r.client.GetPreset(ctx, presetID)
+	if err != nil {
+		if keep.IsNotFound(err) {
+			removeDeletedResource(ctx, resp, "preset", presetID)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading preset",
+			"Could not read preset ID "+presetID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromClientPreset(ctx, preset)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *presetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan presetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// The update replaces all options, so fetch the ones saved outside Terraform
+	current, err := r.client.GetPreset(ctx, plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating preset",
+			"Could not read preset before updating it, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	presetReq, diags := plan.toClientRequest(ctx, current.Options)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the preset via API
+	preset, err := r.client.UpdatePreset(ctx, plan.ID.ValueString(), presetReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating preset",
+			"Could not update preset, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response back to the plan
+	resp.Diagnostics.Append(plan.fromClientPreset(ctx, preset)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *presetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state presetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete preset via API
+	err := r.client.DeletePreset(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting preset",
+			"Could not delete preset, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a preset by ID or by name.
+func (r *presetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	presets, err := r.client.ListPresets(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing preset",
+			"Could not list presets, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	for _, preset := range presets {
+		if preset.ID != req.ID && preset.Name != req.ID {
+			continue
+		}
+		if preset.Static {
+			resp.Diagnostics.AddError(
+				"Cannot Import Static Preset",
+				fmt.Sprintf("Preset %q is built into Keep and cannot be managed by Terraform.", preset.Name),
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), preset.ID)...)
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Preset Not Found",
+		fmt.Sprintf("No preset with ID or name %q exists in Keep.", req.ID),
+	)
+}
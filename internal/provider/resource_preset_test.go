@@ -0,0 +1,138 @@
+// resource_preset_test.go - Acceptance tests for the preset resource
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+func TestPresetOptions(t *testing.T) {
+	existing := []keep.PresetOption{
+		{Label: presetOptionCEL, Value: "severity == 'warning'"},
+		{Label: presetOptionSQL, Value: map[string]interface{}{"sql": "severity = :s", "params": map[string]interface{}{"s": "warning"}}},
+		{Label: "columns", Value: []interface{}{"name", "severity"}},
+	}
+
+	options := presetOptions("severity == 'critical'", "", "service", existing)
+	if len(options) != 3 {
+		t.Fatalf("expected 3 options, got %+v", options)
+	}
+
+	preset := &keep.Preset{Options: options}
+	if got := presetString(preset.Option(presetOptionCEL)); got != "severity == 'critical'" {
+		t.Errorf("unexpected CEL query: %q", got)
+	}
+	if preset.Option(presetOptionSQL) != nil {
+		t.Errorf("expected the stale SQL option to be dropped, got %v", preset.Option(presetOptionSQL))
+	}
+	if got := presetString(preset.Option(presetOptionGroupBy)); got != "service" {
+		t.Errorf("unexpected group_by: %q", got)
+	}
+	if preset.Option("columns") == nil {
+		t.Error("expected options saved outside Terraform to be kept")
+	}
+
+	if got := presetSQL(existing[1].Value); got != "severity = :s" {
+		t.Errorf("unexpected SQL query: %q", got)
+	}
+}
+
+func TestAccPresetResource(t *testing.T) {
+	// Skip if running short tests
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	resourceName := "keep_preset.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccPresetResourceConfig(`severity == "critical"`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPresetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-preset"),
+					resource.TestCheckResourceAttr(resourceName, "cel_query", `severity == "critical"`),
+					resource.TestCheckResourceAttr(resourceName, "is_noisy", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// ImportState testing by name
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     "tf-acc-preset",
+				ImportStateVerify: true,
+				// Timeouts only live in configuration
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccPresetResourceConfig(`severity == "critical" && source == "prometheus"`, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cel_query", `severity == "critical" && source == "prometheus"`),
+					resource.TestCheckResourceAttr(resourceName, "is_noisy", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckPresetExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		client, err := getTestClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GetPreset(context.Background(), rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccPresetResourceConfig(celQuery string, isNoisy bool) string {
+	apiURL := os.Getenv("KEEP_API_URL")
+	if apiURL == "" {
+		apiURL = "http://localhost:8080"
+	}
+
+	return fmt.Sprintf(`
+provider "keep" {
+  api_key = %q
+  api_url = %q
+}
+
+resource "keep_preset" "test" {
+  name      = "tf-acc-preset"
+  cel_query = %q
+  is_noisy  = %t
+  group_by  = "service"
+  tags      = ["tf-acc"]
+}
+`, os.Getenv("KEEP_API_KEY"), apiURL, celQuery, isNoisy)
+}
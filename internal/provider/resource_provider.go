@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,7 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/keephq/terraform-provider-keep/internal/client"
+	"github.com/keephq/terraform-provider-keep/keep"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -31,7 +32,7 @@ func NewProviderResource() resource.Resource {
 
 // providerResource is the resource implementation.
 type providerResource struct {
-	client *client.Client
+	client *keep.Client
 }
 
 // providerResourceModel maps the resource schema data.
@@ -42,10 +43,11 @@ type providerResourceModel struct {
 	Config           types.Map    `tfsdk:"config"`
 	Installed        types.Bool   `tfsdk:"installed"`
 	LastAlertReceived types.String `tfsdk:"last_alert_received"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 // toClientProvider converts the Terraform model to the API client model.
-func (m *providerResourceModel) toClientProvider() (*client.Provider, error) {
+func (m *providerResourceModel) toClientProvider() (*keep.Provider, error) {
 	config := make(map[string]string)
 	if !m.Config.IsNull() && !m.Config.IsUnknown() {
 		diags := m.Config.ElementsAs(context.Background(), &config, false)
@@ -54,7 +56,7 @@ func (m *providerResourceModel) toClientProvider() (*client.Provider, error) {
 		}
 	}
 
-	provider := &client.Provider{
+	provider := &keep.Provider{
 		ID:        m.ID.ValueString(),
 		Name:      m.Name.ValueString(),
 		Type:      m.Type.ValueString(),
@@ -70,7 +72,7 @@ func (m *providerResourceModel) toClientProvider() (*client.Provider, error) {
 }
 
 // fromClientProvider updates the Terraform model from the API client model.
-func (m *providerResourceModel) fromClientProvider(provider *client.Provider) error {
+func (m *providerResourceModel) fromClientProvider(provider *keep.Provider) error {
 	m.ID = types.StringValue(provider.ID)
 	m.Name = types.StringValue(provider.Name)
 	m.Type = types.StringValue(provider.Type)
@@ -99,7 +101,7 @@ func (r *providerResource) Metadata(_ context.Context, req resource.MetadataRequ
 }
 
 // Schema defines the schema for the resource.
-func (r *providerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *providerResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a KeepHQ provider. This resource allows you to create, read, update, and delete providers in KeepHQ.",
 		Attributes: map[string]schema.Attribute{
@@ -142,6 +144,9 @@ func (r *providerResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -151,11 +156,11 @@ func (r *providerResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.Client)
+	client, ok := req.ProviderData.(*keep.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
@@ -173,6 +178,15 @@ func (r *providerResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Creating provider", map[string]interface{}{
 		"name": plan.Name.ValueString(),
 		"type": plan.Type.ValueString(),
@@ -189,7 +203,7 @@ func (r *providerResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	// Create the provider via API
-	createReq := client.CreateProviderRequest{
+	createReq := keep.CreateProviderRequest{
 		Name:   provider.Name,
 		Type:   provider.Type,
 		Config: provider.Config,
@@ -249,14 +263,26 @@ func (r *providerResource) Read(ctx context.Context, req resource.ReadRequest, r
 		"id": providerID,
 	})
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get provider from API
 	provider, err := r.client.GetProvider(ctx, providerID)
 	if err != nil {
-		// If the provider is not found, remove it from state
-		resp.State.RemoveResource(ctx)
-		tflog.Info(ctx, "Provider not found, removing from state", map[string]interface{}{
-			"id": providerID,
-		})
+		if keep.IsNotFound(err) {
+			removeDeletedResource(ctx, resp, "provider", providerID)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading provider",
+			"Could not read provider ID "+providerID+": "+err.Error(),
+		)
 		return
 	}
 
@@ -313,6 +339,15 @@ func (r *providerResource) Update(ctx context.Context, req resource.UpdateReques
 		"id": providerID,
 	})
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	// Convert plan to API model
 	provider, err := plan.toClientProvider()
 	if err != nil {
@@ -324,7 +359,7 @@ func (r *providerResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	// Update the provider via API
-	updateReq := client.UpdateProviderRequest{
+	updateReq := keep.UpdateProviderRequest{
 		Name:   provider.Name,
 		Config: provider.Config,
 	}
@@ -384,6 +419,15 @@ func (r *providerResource) Delete(ctx context.Context, req resource.DeleteReques
 		"name": state.Name.ValueString(),
 	})
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	// Delete the provider via API
 	err := r.client.DeleteProvider(ctx, providerID)
 	if err != nil {
@@ -0,0 +1,27 @@
+// resource_read.go - Shared refresh handling for resources
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// removeDeletedResource drops a resource from state during Read after the API
+// confirmed with a not found error that it no longer exists, so the next plan
+// recreates it. Any other error must be returned as an error diagnostic
+// instead: a network failure or an expired API key does not mean the object is
+// gone. kind is the lowercase name of the object, such as "extraction rule".
+func removeDeletedResource(ctx context.Context, resp *resource.ReadResponse, kind, id string) {
+	tflog.Info(ctx, "Resource not found, removing from state", map[string]interface{}{
+		"kind": kind,
+		"id":   id,
+	})
+	resp.Diagnostics.AddWarning(
+		"Resource Deleted Outside Terraform",
+		fmt.Sprintf("The %s %s no longer exists in Keep and was removed from the Terraform state. It was likely deleted outside Terraform, and will be recreated on the next apply if it is still in the configuration.", kind, id),
+	)
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,149 @@
+// resource_read_test.go - Tests for refresh handling shared by resources
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+func TestResourceReadErrors(t *testing.T) {
+	resources := []struct {
+		name       string
+		resource   func(*keep.Client) resource.Resource
+		attributes map[string]string
+	}{
+		{
+			name:       "provider",
+			resource:   func(c *keep.Client) resource.Resource { return &providerResource{client: c} },
+			attributes: map[string]string{"id": "prometheus-1"},
+		},
+		{
+			name:       "alert",
+			resource:   func(c *keep.Client) resource.Resource { return &AlertResource{client: c} },
+			attributes: map[string]string{"id": "fp-1", "fingerprint": "fp-1"},
+		},
+		{
+			name:       "extraction rule",
+			resource:   func(c *keep.Client) resource.Resource { return &extractionRuleResource{client: c} },
+			attributes: map[string]string{"id": "7"},
+		},
+		{
+			name:       "mapping rule",
+			resource:   func(c *keep.Client) resource.Resource { return &mappingRuleResource{client: c} },
+			attributes: map[string]string{"id": "12"},
+		},
+		{
+			name:       "correlation rule",
+			resource:   func(c *keep.Client) resource.Resource { return &correlationRuleResource{client: c} },
+			attributes: map[string]string{"id": "rule-1"},
+		},
+		{
+			name:       "deduplication rule",
+			resource:   func(c *keep.Client) resource.Resource { return &deduplicationRuleResource{client: c} },
+			attributes: map[string]string{"id": "rule-1"},
+		},
+		{
+			name:       "incident",
+			resource:   func(c *keep.Client) resource.Resource { return &incidentResource{client: c} },
+			attributes: map[string]string{"id": "inc-1"},
+		},
+		{
+			name:       "maintenance window",
+			resource:   func(c *keep.Client) resource.Resource { return &maintenanceWindowResource{client: c} },
+			attributes: map[string]string{"id": "3"},
+		},
+		{
+			name:       "preset",
+			resource:   func(c *keep.Client) resource.Resource { return &presetResource{client: c} },
+			attributes: map[string]string{"id": "preset-1"},
+		},
+		{
+			name:       "topology application",
+			resource:   func(c *keep.Client) resource.Resource { return &topologyApplicationResource{client: c} },
+			attributes: map[string]string{"id": "app-1"},
+		},
+		{
+			name:       "topology dependency",
+			resource:   func(c *keep.Client) resource.Resource { return &topologyDependencyResource{client: c} },
+			attributes: map[string]string{"id": "4"},
+		},
+		{
+			name:       "topology service",
+			resource:   func(c *keep.Client) resource.Resource { return &topologyServiceResource{client: c} },
+			attributes: map[string]string{"id": "5"},
+		},
+		{
+			name:       "workflow",
+			resource:   func(c *keep.Client) resource.Resource { return &workflowResource{client: c} },
+			attributes: map[string]string{"id": "wf-1"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		status      int
+		wantRemoved bool
+		wantError   bool
+		wantWarning bool
+	}{
+		{name: "not found", status: http.StatusNotFound, wantRemoved: true, wantWarning: true},
+		{name: "unauthorized", status: http.StatusUnauthorized, wantError: true},
+		{name: "server error", status: http.StatusInternalServerError, wantError: true},
+	}
+
+	for _, rt := range resources {
+		for _, tt := range tests {
+			t.Run(rt.name+"/"+tt.name, func(t *testing.T) {
+				ctx := context.Background()
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(tt.status)
+					_, _ = w.Write([]byte(`{"detail": "request failed"}`))
+				}))
+				defer server.Close()
+
+				c, err := keep.NewClientFromConfig(keep.Config{
+					BaseURL:     server.URL,
+					APIKey:      "test-key",
+					RetryPolicy: &keep.RetryPolicy{},
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				r := rt.resource(c)
+
+				var schemaResp resource.SchemaResponse
+				r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+				state := tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				}
+				for name, value := range rt.attributes {
+					if diags := state.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+						t.Fatalf("SetAttribute(%s) diagnostics = %v", name, diags)
+					}
+				}
+
+				resp := resource.ReadResponse{State: state}
+				r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+
+				if got := resp.State.Raw.IsNull(); got != tt.wantRemoved {
+					t.Errorf("resource removed = %v, want %v", got, tt.wantRemoved)
+				}
+				if got := resp.Diagnostics.HasError(); got != tt.wantError {
+					t.Errorf("error diagnostics = %v, want %v", resp.Diagnostics.Errors(), tt.wantError)
+				}
+				if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarning {
+					t.Errorf("warning diagnostics = %v, want %v", resp.Diagnostics.Warnings(), tt.wantWarning)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,327 @@
+// resource_topology_application.go - Resource implementation for KeepHQ topology applications
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &topologyApplicationResource{}
+	_ resource.ResourceWithConfigure   = &topologyApplicationResource{}
+	_ resource.ResourceWithImportState = &topologyApplicationResource{}
+)
+
+// NewTopologyApplicationResource is a helper function to simplify the provider implementation.
+func NewTopologyApplicationResource() resource.Resource {
+	return &topologyApplicationResource{}
+}
+
+// topologyApplicationResource defines the resource implementation.
+type topologyApplicationResource struct {
+	client *keep.Client
+}
+
+// topologyApplicationResourceModel maps the resource schema data.
+type topologyApplicationResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Repository  types.String   `tfsdk:"repository"`
+	ServiceIDs  types.Set      `tfsdk:"service_ids"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// toClientApplication converts the Terraform model to a keep.TopologyApplication
+func (m *topologyApplicationResourceModel) toClientApplication(ctx context.Context) (keep.TopologyApplication, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var serviceIDs []string
+	if !m.ServiceIDs.IsNull() {
+		diags.Append(m.ServiceIDs.ElementsAs(ctx, &serviceIDs, false)...)
+	}
+	sort.Strings(serviceIDs)
+
+	services := make([]keep.TopologyApplicationService, 0, len(serviceIDs))
+	for _, id := range serviceIDs {
+		services = append(services, keep.TopologyApplicationService{ID: keep.TopologyID(id)})
+	}
+
+	return keep.TopologyApplication{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Repository:  m.Repository.ValueString(),
+		Services:    services,
+	}, diags
+}
+
+// fromClientApplication sets the model from an application returned by the API
+func (m *topologyApplicationResourceModel) fromClientApplication(ctx context.Context, app *keep.TopologyApplication) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(app.ID)
+	m.Name = types.StringValue(app.Name)
+	m.Description = optionalString(app.Description)
+	m.Repository = optionalString(app.Repository)
+
+	// No services matches an unset service_ids attribute
+	if len(app.Services) > 0 || !m.ServiceIDs.IsNull() {
+		serviceIDs := make([]string, 0, len(app.Services))
+		for _, service := range app.Services {
+			serviceIDs = append(serviceIDs, string(service.ID))
+		}
+		var d diag.Diagnostics
+		m.ServiceIDs, d = types.SetValueFrom(ctx, types.StringType, serviceIDs)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// Metadata returns the resource type name.
+func (r *topologyApplicationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_topology_application"
+}
+
+// Schema defines the schema for the resource.
+func (r *topologyApplicationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an application in the Keep topology. Applications group topology services.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the application.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the application.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the application.",
+				Optional:    true,
+			},
+			"repository": schema.StringAttribute{
+				Description: "Source repository of the application.",
+				Optional:    true,
+			},
+			"service_ids": schema.SetAttribute{
+				Description: "IDs of the topology services that make up the application.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *topologyApplicationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *topologyApplicationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan topologyApplicationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	app, diags := plan.toClientApplication(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the application via API
+	created, err := r.client.CreateTopologyApplication(ctx, app)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating topology application",
+			"Could not create topology application, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Created topology application", map[string]interface{}{
+		"id":       created.ID,
+		"services": len(created.Services),
+	})
+
+	// Map response back to the plan
+	resp.Diagnostics.Append(plan.fromClientApplication(ctx, created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *topologyApplicationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state topologyApplicationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	appID := state.ID.ValueString()
+	app, err := r.client.GetTopologyApplication(ctx, appID)
+	if err != nil {
+		if keep.IsNotFound(err) {
+			removeDeletedResource(ctx, resp, "topology application", appID)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading topology application",
+			"Could not read topology application ID "+appID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromClientApplication(ctx, app)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *topologyApplicationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan topologyApplicationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	app, diags := plan.toClientApplication(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the application via API
+	updated, err := r.client.UpdateTopologyApplication(ctx, plan.ID.ValueString(), app)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating topology application",
+			"Could not update topology application, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response back to the plan
+	resp.Diagnostics.Append(plan.fromClientApplication(ctx, updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *topologyApplicationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state topologyApplicationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete application via API
+	err := r.client.DeleteTopologyApplication(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting topology application",
+			"Could not delete topology application, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *topologyApplicationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
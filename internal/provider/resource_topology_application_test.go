@@ -0,0 +1,110 @@
+// resource_topology_application_test.go - Acceptance tests for the topology_application resource
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTopologyApplicationResource(t *testing.T) {
+	// Skip if running short tests
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	resourceName := "keep_topology_application.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTopologyApplicationResourceConfig("[keep_topology_service.api.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTopologyApplicationExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-shop"),
+					resource.TestCheckResourceAttr(resourceName, "service_ids.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Timeouts only live in configuration
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccTopologyApplicationResourceConfig("[keep_topology_service.api.id, keep_topology_service.db.id]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "service_ids.#", "2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckTopologyApplicationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		client, err := getTestClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GetTopologyApplication(context.Background(), rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccTopologyApplicationResourceConfig(serviceIDs string) string {
+	apiURL := os.Getenv("KEEP_API_URL")
+	if apiURL == "" {
+		apiURL = "http://localhost:8080"
+	}
+
+	return fmt.Sprintf(`
+provider "keep" {
+  api_key = %q
+  api_url = %q
+}
+
+resource "keep_topology_service" "api" {
+  service      = "tf-acc-api"
+  display_name = "TF Acc API"
+}
+
+resource "keep_topology_service" "db" {
+  service      = "tf-acc-db"
+  display_name = "TF Acc Database"
+}
+
+resource "keep_topology_application" "test" {
+  name        = "tf-acc-shop"
+  description = "Created by Terraform"
+  service_ids = %s
+}
+`, os.Getenv("KEEP_API_KEY"), apiURL, serviceIDs)
+}
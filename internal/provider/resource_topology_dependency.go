@@ -0,0 +1,289 @@
+// resource_topology_dependency.go - Resource implementation for KeepHQ topology dependencies
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &topologyDependencyResource{}
+	_ resource.ResourceWithConfigure      = &topologyDependencyResource{}
+	_ resource.ResourceWithImportState    = &topologyDependencyResource{}
+	_ resource.ResourceWithValidateConfig = &topologyDependencyResource{}
+)
+
+// NewTopologyDependencyResource is a helper function to simplify the provider implementation.
+func NewTopologyDependencyResource() resource.Resource {
+	return &topologyDependencyResource{}
+}
+
+// topologyDependencyResource defines the resource implementation.
+type topologyDependencyResource struct {
+	client *keep.Client
+}
+
+// topologyDependencyResourceModel maps the resource schema data.
+type topologyDependencyResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	ServiceID          types.String   `tfsdk:"service_id"`
+	DependsOnServiceID types.String   `tfsdk:"depends_on_service_id"`
+	Protocol           types.String   `tfsdk:"protocol"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// toClientRequest converts the Terraform model to a keep.TopologyDependencyRequest
+func (m *topologyDependencyResourceModel) toClientRequest() keep.TopologyDependencyRequest {
+	return keep.TopologyDependencyRequest{
+		ServiceID:          keep.TopologyID(m.ServiceID.ValueString()),
+		DependsOnServiceID: keep.TopologyID(m.DependsOnServiceID.ValueString()),
+		Protocol:           m.Protocol.ValueString(),
+	}
+}
+
+// fromClientDependency sets the model from a dependency returned by the API
+func (m *topologyDependencyResourceModel) fromClientDependency(dependency *keep.TopologyDependency) {
+	m.ID = types.StringValue(string(dependency.ID))
+	m.ServiceID = types.StringValue(string(dependency.ServiceID))
+	m.DependsOnServiceID = types.StringValue(string(dependency.DependsOnServiceID))
+	m.Protocol = types.StringValue(dependency.Protocol)
+}
+
+// Metadata returns the resource type name.
+func (r *topologyDependencyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_topology_dependency"
+}
+
+// Schema defines the schema for the resource.
+func (r *topologyDependencyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a dependency between two services in the Keep topology.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the dependency.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				Description: "The ID of the topology service that depends on the other one.",
+				Required:    true,
+			},
+			"depends_on_service_id": schema.StringAttribute{
+				Description: "The ID of the topology service depended on.",
+				Required:    true,
+			},
+			"protocol": schema.StringAttribute{
+				Description: "The protocol used between the services, such as HTTP or gRPC. Defaults to \"unknown\".",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("unknown"),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// ValidateConfig rejects a service depending on itself.
+func (r *topologyDependencyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config topologyDependencyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ServiceID.IsUnknown() || config.DependsOnServiceID.IsUnknown() {
+		return
+	}
+	if config.ServiceID.Equal(config.DependsOnServiceID) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("depends_on_service_id"),
+			"Invalid Topology Dependency",
+			"A service cannot depend on itself.",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *topologyDependencyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *topologyDependencyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan topologyDependencyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Create the dependency via API
+	dependency, err := r.client.CreateTopologyDependency(ctx, plan.toClientRequest())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating topology dependency",
+			"Could not create topology dependency, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Created topology dependency", map[string]interface{}{
+		"id":                    string(dependency.ID),
+		"service_id":            string(dependency.ServiceID),
+		"depends_on_service_id": string(dependency.DependsOnServiceID),
+	})
+
+	// Map response back to the plan and set state
+	plan.fromClientDependency(dependency)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *topologyDependencyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state topologyDependencyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	dependencyID := state.ID.ValueString()
+	dependency, err := r.client.GetTopologyDependency(ctx, dependencyID)
+	if err != nil {
+		if keep.IsNotFound(err) {
+			removeDeletedResource(ctx, resp, "topology dependency", dependencyID)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading topology dependency",
+			"Could not read topology dependency ID "+dependencyID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Set refreshed state
+	state.fromClientDependency(dependency)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *topologyDependencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan topologyDependencyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Update the dependency via API
+	dependency, err := r.client.UpdateTopologyDependency(ctx, plan.ID.ValueString(), plan.toClientRequest())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating topology dependency",
+			"Could not update topology dependency, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response back to the plan and set state
+	plan.fromClientDependency(dependency)
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *topologyDependencyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state topologyDependencyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete dependency via API. Deleting either service already removed it.
+	err := r.client.DeleteTopologyDependency(ctx, state.ID.ValueString())
+	if err != nil && !keep.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error deleting topology dependency",
+			"Could not delete topology dependency, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *topologyDependencyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
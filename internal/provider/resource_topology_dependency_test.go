@@ -0,0 +1,116 @@
+// resource_topology_dependency_test.go - Acceptance tests for the topology_dependency resource
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTopologyDependencyResource(t *testing.T) {
+	// Skip if running short tests
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	resourceName := "keep_topology_dependency.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTopologyDependencyResourceConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTopologyDependencyExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "service_id", "keep_topology_service.api", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "depends_on_service_id", "keep_topology_service.db", "id"),
+					resource.TestCheckResourceAttr(resourceName, "protocol", "unknown"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Timeouts only live in configuration
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccTopologyDependencyResourceConfig("postgres"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "protocol", "postgres"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckTopologyDependencyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		client, err := getTestClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GetTopologyDependency(context.Background(), rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccTopologyDependencyResourceConfig(protocol string) string {
+	protocolAttr := ""
+	if protocol != "" {
+		protocolAttr = fmt.Sprintf("protocol = %q", protocol)
+	}
+
+	apiURL := os.Getenv("KEEP_API_URL")
+	if apiURL == "" {
+		apiURL = "http://localhost:8080"
+	}
+
+	return fmt.Sprintf(`
+provider "keep" {
+  api_key = %q
+  api_url = %q
+}
+
+resource "keep_topology_service" "api" {
+  service      = "tf-acc-api"
+  display_name = "TF Acc API"
+}
+
+resource "keep_topology_service" "db" {
+  service      = "tf-acc-db"
+  display_name = "TF Acc Database"
+}
+
+resource "keep_topology_dependency" "test" {
+  service_id            = keep_topology_service.api.id
+  depends_on_service_id = keep_topology_service.db.id
+  %s
+}
+`, os.Getenv("KEEP_API_KEY"), apiURL, protocolAttr)
+}
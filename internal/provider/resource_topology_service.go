@@ -0,0 +1,355 @@
+// resource_topology_service.go - Resource implementation for KeepHQ topology services
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &topologyServiceResource{}
+	_ resource.ResourceWithConfigure   = &topologyServiceResource{}
+	_ resource.ResourceWithImportState = &topologyServiceResource{}
+)
+
+// NewTopologyServiceResource is a helper function to simplify the provider implementation.
+func NewTopologyServiceResource() resource.Resource {
+	return &topologyServiceResource{}
+}
+
+// topologyServiceResource defines the resource implementation.
+type topologyServiceResource struct {
+	client *keep.Client
+}
+
+// topologyServiceResourceModel maps the resource schema data.
+type topologyServiceResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	Service     types.String   `tfsdk:"service"`
+	DisplayName types.String   `tfsdk:"display_name"`
+	Environment types.String   `tfsdk:"environment"`
+	Description types.String   `tfsdk:"description"`
+	Team        types.String   `tfsdk:"team"`
+	Email       types.String   `tfsdk:"email"`
+	Slack       types.String   `tfsdk:"slack"`
+	Repository  types.String   `tfsdk:"repository"`
+	Tags        types.List     `tfsdk:"tags"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// toClientRequest converts the Terraform model to a keep.TopologyServiceRequest
+func (m *topologyServiceResourceModel) toClientRequest(ctx context.Context) (keep.TopologyServiceRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tags := []string{}
+	if !m.Tags.IsNull() {
+		diags.Append(m.Tags.ElementsAs(ctx, &tags, false)...)
+	}
+
+	return keep.TopologyServiceRequest{
+		Service:     m.Service.ValueString(),
+		DisplayName: m.DisplayName.ValueString(),
+		Environment: m.Environment.ValueString(),
+		Description: m.Description.ValueString(),
+		Team:        m.Team.ValueString(),
+		Email:       m.Email.ValueString(),
+		Slack:       m.Slack.ValueString(),
+		Repository:  m.Repository.ValueString(),
+		Tags:        tags,
+	}, diags
+}
+
+// fromClientService sets the model from a topology service returned by the API
+func (m *topologyServiceResourceModel) fromClientService(ctx context.Context, service *keep.TopologyService) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(string(service.ID))
+	m.Service = types.StringValue(service.Service)
+	m.DisplayName = types.StringValue(service.DisplayName)
+	m.Environment = types.StringValue(service.Environment)
+	m.Description = optionalString(service.Description)
+	m.Team = optionalString(service.Team)
+	m.Email = optionalString(service.Email)
+	m.Slack = optionalString(service.Slack)
+	m.Repository = optionalString(service.Repository)
+
+	// No tags matches an unset tags attribute
+	if len(service.Tags) > 0 || !m.Tags.IsNull() {
+		var d diag.Diagnostics
+		m.Tags, d = types.ListValueFrom(ctx, types.StringType, service.Tags)
+		diags.Append(d...)
+	}
+
+	return diags
+}
+
+// Metadata returns the resource type name.
+func (r *topologyServiceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_topology_service"
+}
+
+// Schema defines the schema for the resource.
+func (r *topologyServiceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a manually defined service in the Keep topology. Mapping rules of type topology enrich alerts from these services.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The unique identifier of the topology service.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service": schema.StringAttribute{
+				Description: "The service name alerts refer to, matched against the alert service field.",
+				Required:    true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "The name shown for the service in the topology map.",
+				Required:    true,
+			},
+			"environment": schema.StringAttribute{
+				Description: "The environment the service runs in, such as production. Keep uses \"unknown\" when unset.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the service.",
+				Optional:    true,
+			},
+			"team": schema.StringAttribute{
+				Description: "The team owning the service.",
+				Optional:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "Contact email address of the owning team.",
+				Optional:    true,
+			},
+			"slack": schema.StringAttribute{
+				Description: "Slack channel of the owning team.",
+				Optional:    true,
+			},
+			"repository": schema.StringAttribute{
+				Description: "Source repository of the service.",
+				Optional:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Tags attached to the service.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *topologyServiceResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *topologyServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan topologyServiceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	serviceReq, diags := plan.toClientRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create the topology service via API
+	service, err := r.client.CreateTopologyService(ctx, serviceReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating topology service",
+			"Could not create topology service, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Created topology service", map[string]interface{}{
+		"id":      string(service.ID),
+		"service": service.Service,
+	})
+
+	// Map response back to the plan
+	resp.Diagnostics.Append(plan.fromClientService(ctx, service)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *topologyServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state topologyServiceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	serviceID := state.ID.ValueString()
+	service, err := r.client.GetTopologyService(ctx, serviceID)
+	if err != nil {
+		if keep.IsNotFound(err) {
+			removeDeletedResource(ctx, resp, "topology service", serviceID)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading topology service",
+			"Could not read topology service ID "+serviceID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromClientService(ctx, service)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *topologyServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan topologyServiceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	serviceReq, diags := plan.toClientRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update the topology service via API
+	service, err := r.client.UpdateTopologyService(ctx, plan.ID.ValueString(), serviceReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating topology service",
+			"Could not update topology service, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response back to the plan
+	resp.Diagnostics.Append(plan.fromClientService(ctx, service)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *topologyServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state topologyServiceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete topology service via API
+	err := r.client.DeleteTopologyService(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting topology service",
+			"Could not delete topology service, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *topologyServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,107 @@
+// resource_topology_service_test.go - Acceptance tests for the topology_service resource
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccTopologyServiceResource(t *testing.T) {
+	// Skip if running short tests
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	resourceName := "keep_topology_service.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTopologyServiceResourceConfig("payments"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTopologyServiceExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "service", "tf-acc-checkout"),
+					resource.TestCheckResourceAttr(resourceName, "team", "payments"),
+					resource.TestCheckResourceAttr(resourceName, "environment", "production"),
+					resource.TestCheckResourceAttr(resourceName, "tags.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Timeouts only live in configuration
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccTopologyServiceResourceConfig("sre"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "team", "sre"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckTopologyServiceExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		client, err := getTestClient()
+		if err != nil {
+			return err
+		}
+
+		_, err = client.GetTopologyService(context.Background(), rs.Primary.ID)
+		return err
+	}
+}
+
+func testAccTopologyServiceResourceConfig(team string) string {
+	apiURL := os.Getenv("KEEP_API_URL")
+	if apiURL == "" {
+		apiURL = "http://localhost:8080"
+	}
+
+	return fmt.Sprintf(`
+provider "keep" {
+  api_key = %q
+  api_url = %q
+}
+
+resource "keep_topology_service" "test" {
+  service      = "tf-acc-checkout"
+  display_name = "Checkout"
+  environment  = "production"
+  team         = %q
+  email        = "payments@example.com"
+  slack        = "#payments"
+  repository   = "https://github.com/example/checkout"
+  tags         = ["tier-1", "pci"]
+}
+`, os.Getenv("KEEP_API_KEY"), apiURL, team)
+}
@@ -0,0 +1,561 @@
+// resource_workflow.go - Resource implementation for KeepHQ workflows
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/keephq/terraform-provider-keep/keep"
+	"gopkg.in/yaml.v3"
+)
+
+// workflowServerHashKey is the private state key holding the hash of the
+// workflow definition as stored by Keep after the last apply. Keep may
+// reformat the uploaded YAML, so drift is detected by comparing against what
+// the server returned rather than against the configuration.
+const workflowServerHashKey = "server_content_sha256"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &workflowResource{}
+	_ resource.ResourceWithConfigure        = &workflowResource{}
+	_ resource.ResourceWithImportState      = &workflowResource{}
+	_ resource.ResourceWithConfigValidators = &workflowResource{}
+	_ resource.ResourceWithModifyPlan       = &workflowResource{}
+)
+
+// NewWorkflowResource is a helper function to simplify the provider implementation.
+func NewWorkflowResource() resource.Resource {
+	return &workflowResource{}
+}
+
+// workflowResource defines the resource implementation.
+type workflowResource struct {
+	client *keep.Client
+}
+
+// workflowResourceModel maps the resource schema data.
+type workflowResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	WorkflowYAML  types.String   `tfsdk:"workflow_yaml"`
+	WorkflowFile  types.String   `tfsdk:"workflow_file"`
+	ContentSHA256 types.String   `tfsdk:"content_sha256"`
+	Name          types.String   `tfsdk:"name"`
+	Description   types.String   `tfsdk:"description"`
+	Revision      types.Int64    `tfsdk:"revision"`
+	Disabled      types.Bool     `tfsdk:"disabled"`
+	Triggers      types.List     `tfsdk:"triggers"`
+	CreatedBy     types.String   `tfsdk:"created_by"`
+	LastUpdated   types.String   `tfsdk:"last_updated"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// workflowContent returns the workflow definition from the inline YAML or the file.
+func workflowContent(m workflowResourceModel) (string, error) {
+	if !m.WorkflowYAML.IsNull() {
+		return m.WorkflowYAML.ValueString(), nil
+	}
+
+	content, err := os.ReadFile(m.WorkflowFile.ValueString())
+	if err != nil {
+		return "", fmt.Errorf("could not read workflow_file: %w", err)
+	}
+	return string(content), nil
+}
+
+// workflowContentHash returns the SHA-256 of the normalized workflow definition.
+// Formatting, comments, key order and the optional top-level workflow key do
+// not affect the hash, so only meaningful changes are reported as drift.
+func workflowContentHash(content string) (string, error) {
+	var doc interface{}
+	if err := yaml.Unmarshal([]byte(content), &doc); err != nil {
+		return "", fmt.Errorf("invalid workflow YAML: %w", err)
+	}
+
+	doc = normalizeYAML(doc)
+	if m, ok := doc.(map[string]interface{}); ok && len(m) == 1 {
+		if inner, ok := m["workflow"]; ok {
+			doc = inner
+		}
+	}
+	if _, ok := doc.(map[string]interface{}); !ok {
+		return "", fmt.Errorf("invalid workflow YAML: expected a mapping at the top level")
+	}
+
+	// encoding/json sorts map keys, which makes the encoding canonical
+	encoded, err := json.Marshal(doc)
+	if err != nil {
+		return "", fmt.Errorf("could not encode workflow: %w", err)
+	}
+
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// normalizeYAML converts maps with non-string keys, which yaml.v3 produces for
+// keys such as numbers or booleans, into maps JSON can encode.
+func normalizeYAML(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			v[k] = normalizeYAML(item)
+		}
+		return v
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			m[fmt.Sprint(k)] = normalizeYAML(item)
+		}
+		return m
+	case []interface{}:
+		for i, item := range v {
+			v[i] = normalizeYAML(item)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// fromClientWorkflow sets the computed attributes from a workflow returned by the API.
+func (m *workflowResourceModel) fromClientWorkflow(ctx context.Context, w *keep.Workflow) diag.Diagnostics {
+	m.ID = types.StringValue(w.ID)
+	m.Name = types.StringValue(w.Name)
+	m.Description = optionalString(w.Description)
+	m.Revision = types.Int64Value(int64(w.Revision))
+	m.Disabled = types.BoolValue(w.Disabled)
+	m.CreatedBy = optionalString(w.CreatedBy)
+	m.LastUpdated = optionalString(w.LastUpdated)
+
+	triggers := make([]string, 0, len(w.Triggers))
+	for _, t := range w.Triggers {
+		triggers = append(triggers, t.Type)
+	}
+	var diags diag.Diagnostics
+	m.Triggers, diags = types.ListValueFrom(ctx, types.StringType, triggers)
+	return diags
+}
+
+// serverHash returns the hash of the workflow definition as stored by Keep.
+func serverHash(w *keep.Workflow) (string, error) {
+	hash, err := workflowContentHash(w.WorkflowRaw)
+	if err != nil {
+		return "", fmt.Errorf("could not parse the definition of workflow %s returned by Keep: %w", w.ID, err)
+	}
+	return hash, nil
+}
+
+// Metadata returns the resource type name.
+func (r *workflowResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow"
+}
+
+// Schema defines the schema for the resource.
+func (r *workflowResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a workflow in Keep from its YAML definition. Changes made to the workflow outside of Terraform, such as edits in the Keep UI, are detected and reverted on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID Keep assigned to the workflow.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_yaml": schema.StringAttribute{
+				Description: "The workflow definition in YAML format. Exactly one of workflow_yaml or workflow_file must be set.",
+				Optional:    true,
+			},
+			"workflow_file": schema.StringAttribute{
+				Description: "Path to a file holding the workflow definition in YAML format. Changes to the file contents are detected. Exactly one of workflow_yaml or workflow_file must be set.",
+				Optional:    true,
+			},
+			"content_sha256": schema.StringAttribute{
+				Description: "SHA-256 of the normalized workflow definition. Formatting and comments do not affect it. A change means the definition differs between the configuration and Keep.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the workflow, taken from its definition.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "The description of the workflow, taken from its definition.",
+				Computed:    true,
+			},
+			"revision": schema.Int64Attribute{
+				Description: "The revision of the workflow. Keep increments it on every update.",
+				Computed:    true,
+			},
+			"disabled": schema.BoolAttribute{
+				Description: "Whether the workflow is disabled. Set disabled in the workflow definition to change it.",
+				Computed:    true,
+			},
+			"triggers": schema.ListAttribute{
+				Description: "The types of the workflow triggers, such as manual, interval or alert.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"created_by": schema.StringAttribute{
+				Description: "Who created the workflow.",
+				Computed:    true,
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "When the workflow was last updated.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// ConfigValidators validates that the definition comes from exactly one source.
+func (r *workflowResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("workflow_yaml"),
+			path.MatchRoot("workflow_file"),
+		),
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workflowResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*keep.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *keep.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan plans content_sha256 from the configured definition, so that
+// changes to the workflow file and edits made in Keep result in an update.
+func (r *workflowResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan workflowResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The definition may only be known after other resources are applied
+	if plan.WorkflowYAML.IsUnknown() || plan.WorkflowFile.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), types.StringUnknown())...)
+		return
+	}
+
+	content, err := workflowContent(plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("workflow_file"), "Unable to Read Workflow File", err.Error())
+		return
+	}
+	hash, err := workflowContentHash(content)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Workflow Definition", err.Error())
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), hash)
+	resp.Diagnostics.Append(diags...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var current types.String
+	diags = req.State.GetAttribute(ctx, path.Root("content_sha256"), &current)
+	resp.Diagnostics.Append(diags...)
+
+	// An update creates a new revision and may change the attributes read
+	// from the definition
+	if current.ValueString() != hash {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("description"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("revision"), types.Int64Unknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("disabled"), types.BoolUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("triggers"), types.ListUnknown(types.StringType))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("last_updated"), types.StringUnknown())...)
+	}
+}
+
+// refresh sets the computed attributes after the workflow was created or
+// updated. It returns the private state value recording the definition as
+// stored by Keep.
+func (r *workflowResource) refresh(ctx context.Context, plan *workflowResourceModel, content string) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	workflow, err := r.client.GetWorkflow(ctx, plan.ID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error reading workflow",
+			"Could not read workflow ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	diags.Append(plan.fromClientWorkflow(ctx, workflow)...)
+
+	hash, err := workflowContentHash(content)
+	if err != nil {
+		diags.AddError("Invalid Workflow Definition", err.Error())
+		return nil, diags
+	}
+	plan.ContentSHA256 = types.StringValue(hash)
+
+	stored, err := serverHash(workflow)
+	if err != nil {
+		diags.AddError("Error reading workflow", err.Error())
+		return nil, diags
+	}
+	encoded, _ := json.Marshal(stored)
+
+	return encoded, diags
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *workflowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan workflowResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	content, err := workflowContent(plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("workflow_file"), "Unable to Read Workflow File", err.Error())
+		return
+	}
+
+	// Upload the workflow via API
+	created, err := r.client.CreateWorkflow(ctx, content)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating workflow",
+			"Could not create workflow, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Created workflow", map[string]interface{}{
+		"id":       created.WorkflowID,
+		"revision": created.Revision,
+	})
+
+	// Save the ID first so a failure below does not leave the workflow unmanaged
+	plan.ID = types.StringValue(created.WorkflowID)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	private, diags := r.refresh(ctx, &plan, content)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, workflowServerHashKey, private)...)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *workflowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state workflowResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	workflowID := state.ID.ValueString()
+	workflow, err := r.client.GetWorkflow(ctx, workflowID)
+	if err != nil {
+		if keep.IsNotFound(err) {
+			removeDeletedResource(ctx, resp, "workflow", workflowID)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error reading workflow",
+			"Could not read workflow ID "+workflowID+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromClientWorkflow(ctx, workflow)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stored, err := serverHash(workflow)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading workflow", err.Error())
+		return
+	}
+
+	// Keep the configured hash while the definition in Keep is unchanged since
+	// the last apply. Otherwise it was edited outside of Terraform, or the
+	// workflow was just imported, and the stored definition is reported.
+	var applied string
+	raw, diags := req.Private.GetKey(ctx, workflowServerHashKey)
+	resp.Diagnostics.Append(diags...)
+	if raw != nil {
+		_ = json.Unmarshal(raw, &applied)
+	}
+	if applied != stored {
+		if applied != "" {
+			tflog.Info(ctx, "Workflow definition changed outside of Terraform", map[string]interface{}{
+				"id":       workflowID,
+				"revision": workflow.Revision,
+			})
+		}
+		state.ContentSHA256 = types.StringValue(stored)
+	}
+	if raw == nil {
+		encoded, _ := json.Marshal(stored)
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, workflowServerHashKey, encoded)...)
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *workflowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan workflowResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	content, err := workflowContent(plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("workflow_file"), "Unable to Read Workflow File", err.Error())
+		return
+	}
+
+	// Upload the new definition via API
+	updated, err := r.client.UpdateWorkflow(ctx, plan.ID.ValueString(), content)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating workflow",
+			"Could not update workflow, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Updated workflow", map[string]interface{}{
+		"id":       plan.ID.ValueString(),
+		"revision": updated.Revision,
+	})
+
+	private, diags := r.refresh(ctx, &plan, content)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, workflowServerHashKey, private)...)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *workflowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Get current state
+	var state workflowResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Delete workflow via API
+	err := r.client.DeleteWorkflow(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting workflow",
+			"Could not delete workflow, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState implements resource.ResourceWithImportState.
+func (r *workflowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
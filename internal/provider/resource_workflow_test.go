@@ -0,0 +1,196 @@
+// resource_workflow_test.go - Tests for the workflow resource
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestWorkflowContentHash(t *testing.T) {
+	base, err := workflowContentHash(`
+workflow:
+  id: example
+  description: Example workflow
+  triggers:
+    - type: manual
+`)
+	if err != nil {
+		t.Fatalf("hashing workflow: %s", err)
+	}
+
+	// Formatting, comments, key order and the workflow wrapper do not matter
+	equivalent := []string{
+		"workflow: {id: example, description: Example workflow, triggers: [{type: manual}]}",
+		`
+# Reordered and unwrapped
+triggers:
+  - type: manual
+description: "Example workflow"
+id: example
+`,
+	}
+	for _, content := range equivalent {
+		hash, err := workflowContentHash(content)
+		if err != nil {
+			t.Fatalf("hashing %q: %s", content, err)
+		}
+		if hash != base {
+			t.Errorf("expected %q to hash like the base workflow", content)
+		}
+	}
+
+	changed, err := workflowContentHash(`
+workflow:
+  id: example
+  description: Example workflow
+  triggers:
+    - type: alert
+`)
+	if err != nil {
+		t.Fatalf("hashing workflow: %s", err)
+	}
+	if changed == base {
+		t.Error("expected a changed trigger to change the hash")
+	}
+
+	for _, invalid := range []string{"workflow: [unclosed", "- just\n- a list\n"} {
+		if _, err := workflowContentHash(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestAccWorkflowResource(t *testing.T) {
+	// Skip if running short tests
+	if testing.Short() {
+		t.Skip("Skipping acceptance test in short mode")
+	}
+
+	// Skip if acceptance testing is not enabled
+	if os.Getenv("TF_ACC") == "" {
+		t.Skip("Skipping acceptance test as TF_ACC is not set")
+	}
+
+	resourceName := "keep_workflow.test"
+	var workflowID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccWorkflowResourceConfig("Created by Terraform", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkflowExists(resourceName, &workflowID),
+					resource.TestCheckResourceAttr(resourceName, "name", "tf-acc-workflow"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Created by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.0", "manual"),
+					resource.TestCheckResourceAttrSet(resourceName, "revision"),
+					resource.TestCheckResourceAttrSet(resourceName, "content_sha256"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The definition source and timeouts only live in configuration
+				ImportStateVerifyIgnore: []string{"workflow_yaml", "content_sha256", "timeouts"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccWorkflowResourceConfig("Updated by Terraform", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated by Terraform"),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
+				),
+			},
+			// Edits made outside of Terraform are reverted
+			{
+				PreConfig: func() {
+					c, err := getTestClient()
+					if err != nil {
+						t.Fatal(err)
+					}
+					_, err = c.UpdateWorkflow(context.Background(), workflowID, testAccWorkflowYAML("Edited in the UI", true))
+					if err != nil {
+						t.Fatalf("editing workflow: %s", err)
+					}
+				},
+				Config: testAccWorkflowResourceConfig("Updated by Terraform", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated by Terraform"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccCheckWorkflowExists(n string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		client, err := getTestClient()
+		if err != nil {
+			return err
+		}
+
+		if _, err := client.GetWorkflow(context.Background(), rs.Primary.ID); err != nil {
+			return err
+		}
+
+		*id = rs.Primary.ID
+		return nil
+	}
+}
+
+func testAccWorkflowYAML(description string, disabled bool) string {
+	return fmt.Sprintf(`workflow:
+  id: tf-acc-workflow
+  name: tf-acc-workflow
+  description: %s
+  disabled: %t
+  triggers:
+    - type: manual
+  actions:
+    - name: log
+      provider:
+        type: console
+        with:
+          message: hello from terraform
+`, description, disabled)
+}
+
+func testAccWorkflowResourceConfig(description string, disabled bool) string {
+	apiURL := os.Getenv("KEEP_API_URL")
+	if apiURL == "" {
+		apiURL = "http://localhost:8080"
+	}
+
+	return fmt.Sprintf(`
+provider "keep" {
+  api_key = %q
+  api_url = %q
+}
+
+resource "keep_workflow" "test" {
+  workflow_yaml = %q
+}
+`, os.Getenv("KEEP_API_KEY"), apiURL, testAccWorkflowYAML(description, disabled))
+}
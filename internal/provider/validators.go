@@ -0,0 +1,99 @@
+// validators.go - Shared attribute validators
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = durationValidator{}
+
+// durationValidator checks that a string attribute is a valid Go duration such as "500ms" or "30s".
+type durationValidator struct {
+	// allowZero permits "0s", used where zero means "disabled"
+	allowZero bool
+	// wholeSeconds rejects fractions of a second, for attributes the API
+	// stores in seconds
+	wholeSeconds bool
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v durationValidator) Description(_ context.Context) string {
+	if v.wholeSeconds {
+		return "value must be a positive duration in whole seconds such as \"90s\" or \"2h\""
+	}
+	if v.allowZero {
+		return "value must be a non-negative duration such as \"500ms\" or \"30s\""
+	}
+	return "value must be a positive duration such as \"500ms\" or \"30s\""
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v durationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	d, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err == nil && (d > 0 || (v.allowZero && d == 0)) && (!v.wholeSeconds || d%time.Second == 0) {
+		return
+	}
+
+	detail := v.Description(ctx)
+	if err != nil {
+		detail = fmt.Sprintf("%s: %s", detail, err)
+	}
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Duration",
+		fmt.Sprintf("Attribute %s %s, got: %q", req.Path, detail, req.ConfigValue.ValueString()),
+	)
+}
+
+var _ validator.String = rfc3339Validator{}
+
+// rfc3339Validator checks that a string attribute is an RFC3339 timestamp with a time zone offset, such as "2025-06-01T22:00:00+02:00".
+type rfc3339Validator struct {
+	// wholeSeconds rejects fractions of a second, for attributes the API
+	// stores in seconds
+	wholeSeconds bool
+}
+
+// Description returns a plain text description of the validator's behavior.
+func (v rfc3339Validator) Description(_ context.Context) string {
+	if v.wholeSeconds {
+		return "value must be an RFC3339 timestamp in whole seconds such as \"2025-06-01T22:00:00Z\" or \"2025-06-01T22:00:00+02:00\""
+	}
+	return "value must be an RFC3339 timestamp such as \"2025-06-01T22:00:00Z\" or \"2025-06-01T22:00:00+02:00\""
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v rfc3339Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString performs the validation.
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	t, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString())
+	if err == nil && (!v.wholeSeconds || t.Nanosecond() == 0) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid Timestamp",
+		fmt.Sprintf("Attribute %s %s, got: %q", req.Path, v.Description(ctx), req.ConfigValue.ValueString()),
+	)
+}
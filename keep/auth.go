@@ -0,0 +1,186 @@
+// auth.go - Authentication strategies for the KeepHQ API client
+package keep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenRefreshSkew is how long before expiry a cached OAuth2 token is refreshed
+const tokenRefreshSkew = 30 * time.Second
+
+// Authenticator adds credentials to outgoing API requests
+type Authenticator interface {
+	// Authenticate sets the credentials on req. It is called for every attempt.
+	Authenticate(ctx context.Context, req *http.Request) error
+}
+
+// tokenInvalidator is implemented by authenticators holding a cached token that
+// should be dropped when the API rejects it with a 401.
+type tokenInvalidator interface {
+	InvalidateToken()
+}
+
+// APIKeyAuth authenticates with Keep's X-API-KEY header
+type APIKeyAuth struct {
+	APIKey string
+}
+
+// Authenticate implements Authenticator.
+func (a *APIKeyAuth) Authenticate(_ context.Context, req *http.Request) error {
+	req.Header.Set("X-API-KEY", a.APIKey)
+	return nil
+}
+
+// BearerTokenAuth authenticates with a static bearer token, e.g. a JWT issued by Keep's identity provider
+type BearerTokenAuth struct {
+	Token string
+}
+
+// Authenticate implements Authenticator.
+func (a *BearerTokenAuth) Authenticate(_ context.Context, req *http.Request) error {
+	req.Header.Set("Authorization", "Bearer "+a.Token)
+	return nil
+}
+
+// BasicAuth authenticates with HTTP basic authentication
+type BasicAuth struct {
+	Username string
+	Password string
+}
+
+// Authenticate implements Authenticator.
+func (a *BasicAuth) Authenticate(_ context.Context, req *http.Request) error {
+	req.SetBasicAuth(a.Username, a.Password)
+	return nil
+}
+
+// OAuth2ClientCredentialsAuth obtains bearer tokens from an OAuth2 token endpoint
+// (e.g. Keycloak or Auth0) using the client credentials grant. Tokens are cached
+// and refreshed shortly before they expire.
+type OAuth2ClientCredentialsAuth struct {
+	TokenURL     string
+	ClientID     string
+	ClientSecret string
+	Scopes       []string
+	// Audience is sent as the "audience" parameter, which Auth0 requires
+	Audience string
+	// HTTPClient is used to call the token endpoint. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// Logger receives debug messages about token requests. Defaults to the
+	// logger of the Client using this authenticator.
+	Logger Logger
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// oauth2TokenResponse is the token endpoint response defined in RFC 6749 section 5.1
+type oauth2TokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// Authenticate implements Authenticator.
+func (a *OAuth2ClientCredentialsAuth) Authenticate(ctx context.Context, req *http.Request) error {
+	token, err := a.accessToken(ctx)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	return nil
+}
+
+// InvalidateToken drops the cached token so the next request fetches a new one.
+func (a *OAuth2ClientCredentialsAuth) InvalidateToken() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.token = ""
+	a.expiry = time.Time{}
+}
+
+// accessToken returns the cached token, fetching a new one when it is missing or about to expire.
+func (a *OAuth2ClientCredentialsAuth) accessToken(ctx context.Context) (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token != "" && (a.expiry.IsZero() || time.Now().Add(tokenRefreshSkew).Before(a.expiry)) {
+		return a.token, nil
+	}
+
+	loggerOrNop(a.Logger).Debug(ctx, "Requesting OAuth2 access token", map[string]interface{}{
+		"token_url": a.TokenURL,
+		"client_id": a.ClientID,
+		"scopes":    a.Scopes,
+	})
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", a.ClientID)
+	form.Set("client_secret", a.ClientSecret)
+	if len(a.Scopes) > 0 {
+		form.Set("scope", strings.Join(a.Scopes, " "))
+	}
+	if a.Audience != "" {
+		form.Set("audience", a.Audience)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("error creating token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	httpClient := a.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error requesting OAuth2 token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("error reading OAuth2 token response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("error requesting OAuth2 token: %w", newAPIError(http.MethodPost, a.TokenURL, resp, body))
+	}
+
+	var tokenResp oauth2TokenResponse
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return "", fmt.Errorf("error parsing OAuth2 token response: %w", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return "", fmt.Errorf("OAuth2 token response did not contain an access_token")
+	}
+	if tokenResp.TokenType != "" && !strings.EqualFold(tokenResp.TokenType, "bearer") {
+		return "", fmt.Errorf("unsupported OAuth2 token type %q", tokenResp.TokenType)
+	}
+
+	a.token = tokenResp.AccessToken
+	a.expiry = time.Time{}
+	if tokenResp.ExpiresIn > 0 {
+		a.expiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	}
+
+	loggerOrNop(a.Logger).Debug(ctx, "Obtained OAuth2 access token", map[string]interface{}{
+		"expires_in": tokenResp.ExpiresIn,
+	})
+
+	return a.token, nil
+}
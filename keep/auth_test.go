@@ -0,0 +1,111 @@
+// auth_test.go - Unit tests for client authentication
+package keep
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestAPIKeyAuthHeader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-API-KEY"); got != "test-key" {
+			t.Errorf("X-API-KEY = %q, want %q", got, "test-key")
+		}
+		fmt.Fprint(w, `{"providers": []}`)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 0)
+	if _, err := c.ListProviders(context.Background()); err != nil {
+		t.Fatalf("ListProviders: %s", err)
+	}
+}
+
+func TestOAuth2ClientCredentialsAuth(t *testing.T) {
+	var tokenCalls int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("parsing token request: %s", err)
+		}
+		if r.Form.Get("grant_type") != "client_credentials" || r.Form.Get("client_id") != "terraform" || r.Form.Get("scope") != "keep" {
+			t.Errorf("unexpected token request: %v", r.Form)
+		}
+		n := atomic.AddInt32(&tokenCalls, 1)
+		fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "Bearer", "expires_in": 3600}`, n)
+	}))
+	defer tokenServer.Close()
+
+	// The API rejects the first token to simulate a revoked session
+	var apiCalls int32
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&apiCalls, 1)
+		if r.Header.Get("Authorization") == "Bearer token-1" && atomic.LoadInt32(&apiCalls) == 2 {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `{"providers": []}`)
+	}))
+	defer apiServer.Close()
+
+	c, err := NewClientFromConfig(Config{
+		BaseURL: apiServer.URL,
+		Auth: &OAuth2ClientCredentialsAuth{
+			TokenURL:     tokenServer.URL,
+			ClientID:     "terraform",
+			ClientSecret: "secret",
+			Scopes:       []string{"keep"},
+		},
+		RetryPolicy: &RetryPolicy{},
+	})
+	if err != nil {
+		t.Fatalf("NewClientFromConfig: %s", err)
+	}
+
+	// First call fetches a token, second reuses it and gets a 401, which
+	// triggers exactly one refresh.
+	for i := 0; i < 2; i++ {
+		if _, err := c.ListProviders(context.Background()); err != nil {
+			t.Fatalf("ListProviders call %d: %s", i+1, err)
+		}
+	}
+
+	if got := atomic.LoadInt32(&tokenCalls); got != 2 {
+		t.Errorf("expected 2 token requests, got %d", got)
+	}
+	if got := atomic.LoadInt32(&apiCalls); got != 3 {
+		t.Errorf("expected 3 API requests, got %d", got)
+	}
+}
+
+func TestOAuth2ClientCredentialsAuthDefaultsOnCopy(t *testing.T) {
+	var tokenCalls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			atomic.AddInt32(&tokenCalls, 1)
+			fmt.Fprint(w, `{"access_token": "token", "token_type": "Bearer", "expires_in": 3600}`)
+			return
+		}
+		fmt.Fprint(w, `{"providers": []}`)
+	}))
+	defer server.Close()
+
+	auth := &OAuth2ClientCredentialsAuth{TokenURL: server.URL + "/token", ClientID: "terraform"}
+	c, err := New(server.URL, WithAuth(auth), WithRetry(RetryPolicy{}))
+	if err != nil {
+		t.Fatalf("New: %s", err)
+	}
+	if auth.HTTPClient != nil || auth.Logger != nil {
+		t.Errorf("expected the caller's authenticator to be left unchanged, got HTTP client %v and logger %v", auth.HTTPClient, auth.Logger)
+	}
+
+	if _, err := c.ListProviders(context.Background()); err != nil {
+		t.Fatalf("ListProviders: %s", err)
+	}
+	if got := atomic.LoadInt32(&tokenCalls); got != 1 {
+		t.Errorf("expected 1 token request, got %d", got)
+	}
+}
@@ -0,0 +1,82 @@
+// cache.go - Shared list caches for endpoints without single-item lookups
+package keep
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// listCache holds the result of a list request for the lifetime of a client,
+// which Terraform starts once per plan or apply. Resources refreshed in
+// parallel share a single request through a singleflight group, so N lookups
+// cost one list call instead of N. Writes through the client invalidate it.
+type listCache[T any] struct {
+	group singleflight.Group
+
+	mu    sync.Mutex
+	items []T
+	valid bool
+	// gen counts invalidations, so a list started before a write is not cached
+	gen uint64
+}
+
+// get returns the cached items, calling fetch when the cache is empty. The
+// items are shared and must not be modified.
+func (l *listCache[T]) get(ctx context.Context, fetch func(context.Context) ([]T, error)) ([]T, error) {
+	for {
+		l.mu.Lock()
+		if l.valid {
+			items := l.items
+			l.mu.Unlock()
+			return items, nil
+		}
+		gen := l.gen
+		l.mu.Unlock()
+
+		ch := l.group.DoChan("list", func() (interface{}, error) {
+			items, err := fetch(ctx)
+			if err != nil {
+				return nil, err
+			}
+			l.mu.Lock()
+			if l.gen == gen {
+				l.items, l.valid = items, true
+			}
+			l.mu.Unlock()
+			return items, nil
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case res := <-ch:
+			// The request runs with the context of the first caller. When only
+			// that caller gave up, the others list again with their own.
+			if res.Err != nil && res.Shared && isContextError(res.Err) && ctx.Err() == nil {
+				continue
+			}
+			if res.Err != nil {
+				return nil, res.Err
+			}
+			return res.Val.([]T), nil
+		}
+	}
+}
+
+// invalidate drops the cached items, and detaches callers arriving afterwards
+// from any list request already in flight.
+func (l *listCache[T]) invalidate() {
+	l.mu.Lock()
+	l.items, l.valid = nil, false
+	l.gen++
+	l.mu.Unlock()
+	l.group.Forget("list")
+}
+
+// isContextError reports whether err comes from a canceled or expired context.
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,936 @@
+// client.go - API client for KeepHQ
+package keep
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultTimeout is the default timeout for a single API request attempt
+	DefaultTimeout = 30 * time.Second
+	// DefaultBaseURL is the default base URL for the KeepHQ API
+	DefaultBaseURL = "http://localhost:8080"
+)
+
+// Client is the KeepHQ API client
+type Client struct {
+	baseURL     string
+	httpClient  *http.Client
+	headers     map[string]string
+	auth        Authenticator
+	retryPolicy RetryPolicy
+	limiter     requestLimiter
+	logger      Logger
+
+	// extractionRules caches the extraction rule list for servers without
+	// GET /extraction/{id}, which extractionGetUnsupported records
+	extractionRules          listCache[ExtractionRule]
+	extractionGetUnsupported atomic.Bool
+
+	// workflows caches the workflow list, as GET /workflows/{id} returns
+	// executions rather than the definition
+	workflows listCache[Workflow]
+	// correlationRules caches the correlation rule list, as the rules API has
+	// no endpoint for a single rule
+	correlationRules listCache[CorrelationRule]
+}
+
+// Config holds the settings used to build a Client
+type Config struct {
+	// BaseURL is the URL of the KeepHQ API. Defaults to DefaultBaseURL.
+	BaseURL string
+	// APIKey is sent in the X-API-KEY header when set and Auth is nil
+	APIKey string
+	// Auth authenticates requests. Takes precedence over APIKey.
+	Auth Authenticator
+	// RetryPolicy controls retries of failed requests. Defaults to DefaultRetryPolicy().
+	RetryPolicy *RetryPolicy
+	// TLS customizes certificate verification and client certificates
+	TLS *TLSConfig
+	// ProxyURL routes requests through the given proxy instead of the one from
+	// the HTTPS_PROXY/HTTP_PROXY environment variables
+	ProxyURL string
+	// Timeout bounds each request attempt, including reading the response body.
+	// Defaults to DefaultTimeout. Callers bound the overall operation, retries
+	// included, through the request context.
+	Timeout time.Duration
+	// MaxConcurrentRequests bounds the requests in flight at once. Zero means
+	// no limit.
+	MaxConcurrentRequests int
+	// RequestsPerSecond bounds the rate at which requests, retries included,
+	// are sent. Zero means no limit.
+	RequestsPerSecond float64
+	// HTTPClient sends the requests instead of a client built from TLS,
+	// ProxyURL and Timeout, which must then be left unset
+	HTTPClient *http.Client
+	// Logger receives debug, warning and error messages. Defaults to
+	// discarding them.
+	Logger Logger
+}
+
+// NewClient creates a new KeepHQ API client
+func NewClient(baseURL, apiKey string) (*Client, error) {
+	return NewClientFromConfig(Config{
+		BaseURL: baseURL,
+		APIKey:  apiKey,
+	})
+}
+
+// NewClientFromConfig creates a new KeepHQ API client from a Config
+func NewClientFromConfig(cfg Config) (*Client, error) {
+	// Set default base URL if not provided
+	baseURL := cfg.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	retryPolicy := DefaultRetryPolicy()
+	if cfg.RetryPolicy != nil {
+		retryPolicy = *cfg.RetryPolicy
+	}
+	if retryPolicy.MaxRetries < 0 {
+		return nil, fmt.Errorf("max retries must not be negative, got %d", retryPolicy.MaxRetries)
+	}
+	if retryPolicy.WaitMin > retryPolicy.WaitMax {
+		return nil, fmt.Errorf("minimum retry wait (%s) must not exceed maximum retry wait (%s)", retryPolicy.WaitMin, retryPolicy.WaitMax)
+	}
+
+	timeout := cfg.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	if timeout < 0 {
+		return nil, fmt.Errorf("request timeout must not be negative, got %s", timeout)
+	}
+
+	limiter, err := newRequestLimiter(cfg.MaxConcurrentRequests, cfg.RequestsPerSecond)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := make(map[string]string)
+	headers["Content-Type"] = "application/json"
+	headers["Accept"] = "application/json"
+
+	// Fall back to API key authentication if no other method is configured
+	auth := cfg.Auth
+	if auth == nil && cfg.APIKey != "" {
+		auth = &APIKeyAuth{APIKey: cfg.APIKey}
+	}
+
+	httpClient := cfg.HTTPClient
+	if httpClient != nil {
+		if cfg.TLS != nil || cfg.ProxyURL != "" || cfg.Timeout != 0 {
+			return nil, fmt.Errorf("TLS, proxy URL and timeout cannot be combined with a custom HTTP client")
+		}
+		timeout = httpClient.Timeout
+	} else {
+		transport, err := newTransport(cfg.TLS, cfg.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		httpClient = &http.Client{
+			Timeout:   timeout,
+			Transport: transport,
+		}
+	}
+
+	logger := loggerOrNop(cfg.Logger)
+
+	// Token requests go through the same HTTP client and logger as API
+	// requests. The defaults are set on a copy, leaving the caller's
+	// authenticator unchanged.
+	if oauth, ok := auth.(*OAuth2ClientCredentialsAuth); ok && (oauth.HTTPClient == nil || oauth.Logger == nil) {
+		withDefaults := &OAuth2ClientCredentialsAuth{
+			TokenURL:     oauth.TokenURL,
+			ClientID:     oauth.ClientID,
+			ClientSecret: oauth.ClientSecret,
+			Scopes:       oauth.Scopes,
+			Audience:     oauth.Audience,
+			HTTPClient:   oauth.HTTPClient,
+			Logger:       oauth.Logger,
+		}
+		if withDefaults.HTTPClient == nil {
+			withDefaults.HTTPClient = httpClient
+		}
+		if withDefaults.Logger == nil {
+			withDefaults.Logger = logger
+		}
+		auth = withDefaults
+	}
+
+	logger.Debug(context.Background(), "Creating new KeepHQ API client",
+		map[string]interface{}{
+			"base_url":       baseURL,
+			"timeout":        timeout.String(),
+			"auth_type":      fmt.Sprintf("%T", auth),
+			"max_retries":    retryPolicy.MaxRetries,
+			"retry_wait_min": retryPolicy.WaitMin.String(),
+			"retry_wait_max": retryPolicy.WaitMax.String(),
+			"custom_tls":     cfg.TLS != nil,
+			"custom_http":    cfg.HTTPClient != nil,
+			"proxy_url_set":  cfg.ProxyURL != "",
+			"max_concurrent": cfg.MaxConcurrentRequests,
+			"rate_limit":     cfg.RequestsPerSecond,
+		})
+
+	return &Client{
+		baseURL:     baseURL,
+		headers:     headers,
+		auth:        auth,
+		retryPolicy: retryPolicy,
+		limiter:     limiter,
+		logger:      logger,
+		httpClient:  httpClient,
+	}, nil
+}
+
+// doRequest performs an HTTP request with the given method, path, and JSON body,
+// retrying transient failures according to the client's retry policy.
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	// Marshal the request body once so it can be replayed on retries
+	var jsonBody []byte
+	if body != nil {
+		var err error
+		jsonBody, err = json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling request body: %w", err)
+		}
+	}
+
+	return c.doRawRequest(ctx, method, path, "", jsonBody)
+}
+
+// doRawRequest performs an HTTP request with an already encoded body, retrying
+// transient failures according to the client's retry policy. A non-empty
+// contentType replaces the default JSON content type. Each attempt waits for
+// the client's concurrency and rate limits.
+func (c *Client) doRawRequest(ctx context.Context, method, path, contentType string, payload []byte) ([]byte, error) {
+	tokenRefreshed := false
+	for attempt := 0; ; attempt++ {
+		release, err := c.limiter.acquire(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error waiting to send request %s %s: %w", method, path, err)
+		}
+		respBody, resp, err := c.doAttempt(ctx, method, path, contentType, payload)
+		release()
+		if err == nil {
+			return respBody, nil
+		}
+
+		// A rejected cached token is refreshed once without consuming a retry
+		if invalidator, ok := c.auth.(tokenInvalidator); ok && IsUnauthorized(err) && !tokenRefreshed {
+			c.logger.Debug(ctx, "Request unauthorized, refreshing access token", map[string]interface{}{
+				"method": method,
+				"path":   path,
+			})
+			invalidator.InvalidateToken()
+			tokenRefreshed = true
+			attempt--
+			continue
+		}
+
+		statusCode := 0
+		var transportErr error
+		if resp != nil {
+			statusCode = resp.StatusCode
+		} else {
+			transportErr = err
+		}
+
+		if attempt >= c.retryPolicy.MaxRetries || !shouldRetry(ctx, method, path, statusCode, transportErr) {
+			return nil, err
+		}
+
+		wait := c.retryPolicy.backoff(attempt, resp)
+		// Give up right away when the context would expire before the retry
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return nil, err
+		}
+		c.logger.Warn(ctx, "Retrying KeepHQ API request", map[string]interface{}{
+			"method":      method,
+			"path":        path,
+			"attempt":     attempt + 1,
+			"max_retries": c.retryPolicy.MaxRetries,
+			"wait":        wait.String(),
+			"error":       err.Error(),
+		})
+
+		if sleepErr := sleepContext(ctx, wait); sleepErr != nil {
+			return nil, err
+		}
+	}
+}
+
+// doAttempt performs a single HTTP round trip. The response is returned
+// alongside an error for non-2xx statuses so the caller can inspect headers.
+func (c *Client) doAttempt(ctx context.Context, method, path, contentType string, payload []byte) ([]byte, *http.Response, error) {
+	// Add debug logging for the client configuration
+	c.logger.Debug(ctx, "Client configuration", map[string]interface{}{
+		"baseURL": c.baseURL,
+	})
+
+	var reqBody io.Reader = http.NoBody
+	if payload != nil {
+		reqBody = bytes.NewReader(payload)
+	}
+
+	// Create the request
+	url := c.baseURL + path
+	c.logger.Debug(ctx, "Creating request", map[string]interface{}{
+		"method": method,
+		"url":    url,
+	})
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	// Add headers
+	for k, v := range c.headers {
+		req.Header.Add(k, v)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	// Add credentials
+	if c.auth != nil {
+		if err := c.auth.Authenticate(ctx, req); err != nil {
+			return nil, nil, fmt.Errorf("error authenticating request: %w", err)
+		}
+	}
+
+	c.logger.Debug(ctx, "Sending request with headers", map[string]interface{}{
+		"method":  method,
+		"url":     req.URL.String(),
+		"path":    path,
+		"headers": redactHeaders(req.Header),
+	})
+
+	// Execute the request
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Read the response body
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading response body: %w", err)
+	}
+
+	c.logger.Debug(ctx, "Received response", map[string]interface{}{
+		"status":     resp.Status,
+		"statusCode": resp.StatusCode,
+	})
+
+	// Check for error responses
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		apiErr := newAPIError(method, path, resp, respBody)
+		c.logger.Debug(ctx, "API request failed", map[string]interface{}{
+			"method":     method,
+			"path":       path,
+			"statusCode": apiErr.StatusCode,
+			"request_id": apiErr.RequestID,
+			"detail":     apiErr.Detail,
+		})
+		return nil, resp, apiErr
+	}
+
+	return respBody, resp, nil
+}
+
+// redactHeaders returns the request headers as a loggable map with credentials masked
+func redactHeaders(header http.Header) map[string]string {
+	logHeaders := make(map[string]string, len(header))
+	for k, v := range header {
+		switch http.CanonicalHeaderKey(k) {
+		case "X-Api-Key", "Authorization":
+			logHeaders[k] = "[REDACTED]"
+		default:
+			logHeaders[k] = strings.Join(v, ", ")
+		}
+	}
+	return logHeaders
+}
+
+// Get performs a GET request
+func (c *Client) Get(ctx context.Context, path string) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodGet, path, nil)
+}
+
+// Post performs a POST request
+func (c *Client) Post(ctx context.Context, path string, body interface{}) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodPost, path, body)
+}
+
+// Put performs a PUT request
+func (c *Client) Put(ctx context.Context, path string, body interface{}) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodPut, path, body)
+}
+
+// Delete performs a DELETE request
+func (c *Client) Delete(ctx context.Context, path string) ([]byte, error) {
+	return c.doRequest(ctx, http.MethodDelete, path, nil)
+}
+
+// ExtractionRule represents an extraction rule in Keep
+// This mirrors the API response structure
+// https://github.com/keephq/keep/blob/main/keep/api/models/db/extraction.py
+// https://github.com/keephq/keep/blob/main/keep/api/routes/extraction.py
+type ExtractionRule struct {
+	ID          ID      `json:"id,omitempty"`
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Priority    int     `json:"priority"`
+	Disabled    bool    `json:"disabled"`
+	Pre         bool    `json:"pre"`
+	Condition   *string `json:"condition,omitempty"`
+	Attribute   *string `json:"attribute,omitempty"`
+	Regex       string  `json:"regex"`
+	CreatedAt   string  `json:"created_at,omitempty"`
+	UpdatedAt   *string `json:"updated_at,omitempty"`
+	CreatedBy   string  `json:"created_by,omitempty"`
+	UpdatedBy   *string `json:"updated_by,omitempty"`
+}
+
+// CreateExtractionRule creates a new extraction rule
+func (c *Client) CreateExtractionRule(ctx context.Context, rule ExtractionRule) (*ExtractionRule, error) {
+	defer c.extractionRules.invalidate()
+
+	path := "/extraction"
+	body, err := c.Post(ctx, path, rule)
+	if err != nil {
+		return nil, fmt.Errorf("error creating extraction rule: %w", err)
+	}
+
+	var result ExtractionRule
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("error parsing extraction rule response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetExtractionRule retrieves an extraction rule by ID. It uses
+// GET /extraction/{id} when the server supports it, and otherwise looks the
+// rule up in the extraction rule list, which is fetched once and shared by all
+// lookups until the client next writes an extraction rule.
+func (c *Client) GetExtractionRule(ctx context.Context, id string) (*ExtractionRule, error) {
+	if !c.extractionGetUnsupported.Load() {
+		body, err := c.Get(ctx, "/extraction/"+url.PathEscape(id))
+		switch {
+		case err == nil:
+			var rule ExtractionRule
+			if err := json.Unmarshal(body, &rule); err == nil {
+				return &rule, nil
+			}
+			// Not a rule, so the route is something else on this server
+			c.extractionGetUnsupported.Store(true)
+		case StatusCode(err) == http.StatusMethodNotAllowed:
+			c.extractionGetUnsupported.Store(true)
+		case !IsNotFound(err):
+			// Only a missing route or rule warrants the list fallback; auth and
+			// server errors would fail the same way there.
+			return nil, fmt.Errorf("error getting extraction rule: %w", err)
+		}
+	}
+
+	rules, err := c.extractionRules.get(ctx, c.ListExtractionRules)
+	if err != nil {
+		return nil, fmt.Errorf("error listing extraction rules: %w", err)
+	}
+
+	for _, rule := range rules {
+		if string(rule.ID) == id {
+			// rule is a copy, as the cached list is shared
+			return &rule, nil
+		}
+	}
+
+	return nil, newNotFoundError(http.MethodGet, "/extraction", fmt.Sprintf("extraction rule with ID %s not found", id))
+}
+
+// UpdateExtractionRule updates an existing extraction rule
+func (c *Client) UpdateExtractionRule(ctx context.Context, id string, rule ExtractionRule) (*ExtractionRule, error) {
+	defer c.extractionRules.invalidate()
+
+	path := fmt.Sprintf("/extraction/%s", id)
+	body, err := c.Put(ctx, path, rule)
+	if err != nil {
+		return nil, fmt.Errorf("error updating extraction rule: %w", err)
+	}
+
+	var result ExtractionRule
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("error parsing updated extraction rule: %w", err)
+	}
+
+	return &result, nil
+}
+
+// DeleteExtractionRule deletes an extraction rule by ID
+func (c *Client) DeleteExtractionRule(ctx context.Context, id string) error {
+	defer c.extractionRules.invalidate()
+
+	path := fmt.Sprintf("/extraction/%s", id)
+	_, err := c.Delete(ctx, path)
+	if err != nil {
+		return fmt.Errorf("error deleting extraction rule: %w", err)
+	}
+	return nil
+}
+
+// ListExtractionRules retrieves all extraction rules
+func (c *Client) ListExtractionRules(ctx context.Context) ([]ExtractionRule, error) {
+	path := "/extraction"
+	body, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("error listing extraction rules: %w", err)
+	}
+
+	var rules []ExtractionRule
+	if err := json.Unmarshal(body, &rules); err != nil {
+		return nil, fmt.Errorf("error parsing extraction rules list: %w", err)
+	}
+
+	return rules, nil
+}
+
+// Alert represents an alert in KeepHQ
+type Alert struct {
+	ID           string    `json:"id,omitempty"`
+	Fingerprint  string    `json:"fingerprint,omitempty"`
+	Name         string    `json:"name"`
+	Status       string    `json:"status,omitempty"`
+	Severity     string    `json:"severity,omitempty"`
+	Environment  string    `json:"environment,omitempty"`
+	Service      *string   `json:"service,omitempty"`
+	Source       []string  `json:"source,omitempty"`
+	Message      *string   `json:"message,omitempty"`
+	Description  *string   `json:"description,omitempty"`
+	URL          *string   `json:"url,omitempty"`
+	ImageURL     *string   `json:"image_url,omitempty"`
+	Labels       StringMap `json:"labels,omitempty"`
+	LastReceived string    `json:"lastReceived,omitempty"`
+}
+
+// AlertEnrichment holds the alert fields changed by EnrichAlert
+type AlertEnrichment struct {
+	Fingerprint string            `json:"fingerprint"`
+	Status      string            `json:"status"`
+	Severity    string            `json:"severity"`
+	Message     string            `json:"message"`
+	Description string            `json:"description"`
+	Labels      map[string]string `json:"labels"`
+}
+
+// CreateAlert creates a new alert
+func (c *Client) CreateAlert(ctx context.Context, alert Alert) (*Alert, error) {
+	path := "/alerts/event"
+	body, err := c.Post(ctx, path, alert)
+	if err != nil {
+		return nil, fmt.Errorf("error creating alert: %w", err)
+	}
+
+	var result Alert
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("error parsing alert response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetAlert retrieves an alert by fingerprint
+func (c *Client) GetAlert(ctx context.Context, fingerprint string) (*Alert, error) {
+	path := fmt.Sprintf("/alerts/%s", fingerprint)
+	body, err := c.Get(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("error getting alert: %w", err)
+	}
+
+	var result Alert
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("error parsing alert: %w", err)
+	}
+
+	return &result, nil
+}
+
+// SearchAlerts searches for alerts
+func (c *Client) SearchAlerts(ctx context.Context, query map[string]interface{}) ([]Alert, error) {
+	path := "/alerts/search"
+	body, err := c.Post(ctx, path, query)
+	if err != nil {
+		return nil, fmt.Errorf("error searching alerts: %w", err)
+	}
+
+	var result []Alert
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("error parsing search results: %w", err)
+	}
+
+	return result, nil
+}
+
+// EnrichAlert enriches an alert with additional data
+func (c *Client) EnrichAlert(ctx context.Context, enrichment AlertEnrichment) error {
+	path := "/alerts/enrich"
+	_, err := c.Post(ctx, path, enrichment)
+	if err != nil {
+		return fmt.Errorf("error enriching alert: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteAlert deletes an alert by fingerprint
+func (c *Client) DeleteAlert(ctx context.Context, fingerprint string) error {
+	_, err := c.Delete(ctx, fmt.Sprintf("/alerts/%s", fingerprint))
+	if err != nil {
+		return fmt.Errorf("failed to delete alert: %w", err)
+	}
+
+	return nil
+}
+
+// Incident represents an incident as returned by Keep
+type Incident struct {
+	ID                string  `json:"id"`
+	UserGeneratedName string  `json:"user_generated_name"`
+	UserSummary       *string `json:"user_summary"`
+	Assignee          *string `json:"assignee"`
+	Severity          string  `json:"severity"`
+	Status            string  `json:"status"`
+	CreationTime      *string `json:"creation_time"`
+}
+
+// IncidentRequest represents the writable fields of an incident in Keep
+type IncidentRequest struct {
+	UserGeneratedName string `json:"user_generated_name"`
+	UserSummary       string `json:"user_summary,omitempty"`
+	Assignee          string `json:"assignee,omitempty"`
+	Severity          string `json:"severity,omitempty"`
+}
+
+// CreateIncident creates a new incident
+func (c *Client) CreateIncident(ctx context.Context, incident IncidentRequest) (*Incident, error) {
+	body, err := c.Post(ctx, "/incidents", incident)
+	if err != nil {
+		return nil, fmt.Errorf("error creating incident: %w", err)
+	}
+
+	var result Incident
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("error parsing incident response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// GetIncident retrieves an incident by ID
+func (c *Client) GetIncident(ctx context.Context, id string) (*Incident, error) {
+	body, err := c.Get(ctx, fmt.Sprintf("/incidents/%s", url.PathEscape(id)))
+	if err != nil {
+		return nil, fmt.Errorf("error getting incident: %w", err)
+	}
+
+	var result Incident
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("error parsing incident: %w", err)
+	}
+
+	return &result, nil
+}
+
+// UpdateIncident updates the name, summary, assignee and severity of an incident
+func (c *Client) UpdateIncident(ctx context.Context, id string, incident IncidentRequest) (*Incident, error) {
+	body, err := c.Put(ctx, fmt.Sprintf("/incidents/%s", url.PathEscape(id)), incident)
+	if err != nil {
+		return nil, fmt.Errorf("error updating incident: %w", err)
+	}
+
+	var result Incident
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("error parsing incident response: %w", err)
+	}
+
+	return &result, nil
+}
+
+// ChangeIncidentStatus changes the status of an incident, for example to resolve it
+func (c *Client) ChangeIncidentStatus(ctx context.Context, id, status string) error {
+	_, err := c.Post(ctx, fmt.Sprintf("/incidents/%s/status", url.PathEscape(id)), map[string]string{
+		"status": status,
+	})
+	if err != nil {
+		return fmt.Errorf("error changing incident status: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteIncident deletes an incident by ID
+func (c *Client) DeleteIncident(ctx context.Context, id string) error {
+	_, err := c.Delete(ctx, fmt.Sprintf("/incidents/%s", url.PathEscape(id)))
+	if err != nil {
+		return fmt.Errorf("error deleting incident: %w", err)
+	}
+
+	return nil
+}
+
+// ListIncidentAlertFingerprints returns the fingerprints of all alerts linked to an incident
+func (c *Client) ListIncidentAlertFingerprints(ctx context.Context, id string) ([]string, error) {
+	const pageSize = 100
+
+	fingerprints := []string{}
+	for offset := 0; ; offset += pageSize {
+		body, err := c.Get(ctx, fmt.Sprintf("/incidents/%s/alerts?limit=%d&offset=%d", url.PathEscape(id), pageSize, offset))
+		if err != nil {
+			return nil, fmt.Errorf("error listing incident alerts: %w", err)
+		}
+
+		var page struct {
+			Items []struct {
+				Fingerprint string `json:"fingerprint"`
+			} `json:"items"`
+			Count int `json:"count"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			return nil, fmt.Errorf("error parsing incident alerts: %w", err)
+		}
+
+		for _, item := range page.Items {
+			fingerprints = append(fingerprints, item.Fingerprint)
+		}
+		if len(page.Items) < pageSize || offset+len(page.Items) >= page.Count {
+			return fingerprints, nil
+		}
+	}
+}
+
+// AddAlertsToIncident links the alerts with the given fingerprints to an incident
+func (c *Client) AddAlertsToIncident(ctx context.Context, id string, fingerprints []string) error {
+	_, err := c.Post(ctx, fmt.Sprintf("/incidents/%s/alerts", url.PathEscape(id)), fingerprints)
+	if err != nil {
+		return fmt.Errorf("error adding alerts to incident: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveAlertsFromIncident unlinks the alerts with the given fingerprints from an incident
+func (c *Client) RemoveAlertsFromIncident(ctx context.Context, id string, fingerprints []string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, fmt.Sprintf("/incidents/%s/alerts", url.PathEscape(id)), fingerprints)
+	if err != nil {
+		return fmt.Errorf("error removing alerts from incident: %w", err)
+	}
+
+	return nil
+}
+
+// MappingRule represents a mapping rule in Keep
+// This mirrors the API response structure
+// https://github.com/keephq/keep/blob/main/keep/api/models/db/mapping.py
+// https://github.com/keephq/keep/blob/main/keep/api/routes/mapping.py
+// Keep calls the update timestamp last_updated_at; some versions return updated_at.
+type MappingRule struct {
+	ID            ID                  `json:"id,omitempty"`
+	Name          string              `json:"name"`
+	Description   *string             `json:"description,omitempty"`
+	Priority      int                 `json:"priority"`
+	Disabled      *bool               `json:"disabled,omitempty"`
+	Matchers      MappingRuleMatchers `json:"matchers"`
+	Type          string              `json:"type,omitempty"`
+	FileName      string              `json:"file_name,omitempty"`
+	CSVData       *string             `json:"csv_data,omitempty"`
+	Rows          []StringMap         `json:"rows,omitempty"`
+	CreatedAt     string              `json:"created_at,omitempty"`
+	CreatedBy     string              `json:"created_by,omitempty"`
+	LastUpdatedAt *string             `json:"last_updated_at,omitempty"`
+	UpdatedAt     *string             `json:"updated_at,omitempty"`
+	UpdatedBy     *string             `json:"updated_by,omitempty"`
+}
+
+// CreateMappingRule creates a new mapping rule
+func (c *Client) CreateMappingRule(ctx context.Context, rule MappingRule) (*MappingRule, error) {
+	c.logger.Debug(ctx, "Creating mapping rule", map[string]interface{}{
+		"name": rule.Name,
+		"type": rule.Type,
+		"rows": len(rule.Rows),
+	})
+
+	resp, err := c.Post(ctx, "/mapping", rule)
+	if err != nil {
+		c.logger.Error(ctx, "Failed to create mapping rule", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to create mapping rule: %w", err)
+	}
+
+	var result MappingRule
+	if err := json.Unmarshal(resp, &result); err != nil {
+		c.logger.Error(ctx, "Failed to unmarshal response", map[string]interface{}{
+			"error":    err.Error(),
+			"response": string(resp),
+		})
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	c.logger.Debug(ctx, "Created mapping rule", map[string]interface{}{
+		"id":   string(result.ID),
+		"rows": len(result.Rows),
+	})
+
+	return &result, nil
+}
+
+// GetMappingRule retrieves a mapping rule by ID
+func (c *Client) GetMappingRule(ctx context.Context, id string) (*MappingRule, error) {
+	c.logger.Debug(ctx, "Getting mapping rule", map[string]interface{}{
+		"id": id,
+	})
+
+	// Try to get the rule directly by ID first
+	resp, err := c.Get(ctx, "/mapping/"+id)
+	if err == nil {
+		var rule MappingRule
+		if err = json.Unmarshal(resp, &rule); err == nil {
+			c.logger.Debug(ctx, "Found mapping rule by direct ID lookup", map[string]interface{}{
+				"id": id,
+			})
+			return &rule, nil
+		}
+	} else if !IsNotFound(err) && StatusCode(err) != http.StatusMethodNotAllowed {
+		// Only a missing route or rule warrants the list fallback; auth and
+		// server errors would fail the same way there.
+		return nil, fmt.Errorf("failed to get mapping rule: %w", err)
+	}
+
+	c.logger.Debug(ctx, "Direct lookup failed, falling back to listing all rules", map[string]interface{}{
+		"id":    id,
+		"error": err.Error(),
+	})
+
+	// Fall back to listing all rules if direct lookup fails
+	rules, err := c.ListMappingRules(ctx)
+	if err != nil {
+		c.logger.Error(ctx, "Failed to list mapping rules", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to list mapping rules: %w", err)
+	}
+
+	// Find the rule with the matching ID
+	for i := range rules {
+		if string(rules[i].ID) == id {
+			c.logger.Debug(ctx, "Found mapping rule in list", map[string]interface{}{
+				"id": id,
+			})
+			return &rules[i], nil
+		}
+	}
+
+	c.logger.Error(ctx, "Mapping rule not found", map[string]interface{}{
+		"id":          id,
+		"rules_count": len(rules),
+	})
+
+	return nil, newNotFoundError(http.MethodGet, "/mapping", fmt.Sprintf("mapping rule with ID %s not found", id))
+}
+
+// UpdateMappingRule updates an existing mapping rule in place with PUT /mapping/{id}.
+// Keep versions without that route get a replacement rule, created before the
+// previous one is deleted so alerts are enriched throughout; the returned rule
+// then has a new ID. If only the delete fails, the replacement is returned
+// together with a *StaleMappingRuleError.
+func (c *Client) UpdateMappingRule(ctx context.Context, id string, rule MappingRule) (*MappingRule, error) {
+	// The update body repeats the rule ID, which ID sends as a number when numeric
+	body := rule
+	body.ID = ID(id)
+
+	resp, err := c.Put(ctx, "/mapping/"+url.PathEscape(id), body)
+	if err == nil {
+		var result MappingRule
+		if err := json.Unmarshal(resp, &result); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+		return &result, nil
+	}
+	if !IsNotFound(err) && StatusCode(err) != http.StatusMethodNotAllowed {
+		return nil, fmt.Errorf("failed to update mapping rule: %w", err)
+	}
+
+	c.logger.Debug(ctx, "In-place mapping rule update not available, replacing the rule", map[string]interface{}{
+		"id":    id,
+		"error": err.Error(),
+	})
+
+	rule.ID = ""
+	created, err := c.CreateMappingRule(ctx, rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create replacement mapping rule: %w", err)
+	}
+
+	if err := c.DeleteMappingRule(ctx, id); err != nil && !IsNotFound(err) {
+		return created, &StaleMappingRuleError{ID: id, Err: err}
+	}
+
+	return created, nil
+}
+
+// DeleteMappingRule deletes a mapping rule by ID
+func (c *Client) DeleteMappingRule(ctx context.Context, id string) error {
+	_, err := c.Delete(ctx, fmt.Sprintf("/mapping/%s", id))
+	if err != nil {
+		return fmt.Errorf("failed to delete mapping rule: %w", err)
+	}
+
+	return nil
+}
+
+// ListMappingRules retrieves all mapping rules
+func (c *Client) ListMappingRules(ctx context.Context) ([]MappingRule, error) {
+	c.logger.Debug(ctx, "Listing all mapping rules", nil)
+
+	resp, err := c.Get(ctx, "/mapping")
+	if err != nil {
+		c.logger.Error(ctx, "Failed to list mapping rules", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("failed to list mapping rules: %w", err)
+	}
+
+	// Log the raw response for debugging
+	c.logger.Debug(ctx, "Raw mapping rules response", map[string]interface{}{
+		"response": string(resp),
+	})
+
+	var rules []MappingRule
+	if err := json.Unmarshal(resp, &rules); err != nil {
+		c.logger.Error(ctx, "Failed to unmarshal mapping rules response", map[string]interface{}{
+			"error":    err.Error(),
+			"response": string(resp),
+		})
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+
+	c.logger.Debug(ctx, "Retrieved mapping rules", map[string]interface{}{
+		"count": len(rules),
+	})
+
+	return rules, nil
+}
@@ -0,0 +1,324 @@
+// client_test.go - Unit tests for the KeepHQ API client endpoints
+package keep
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGetMappingRuleListFallback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/mapping" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		fmt.Fprint(w, `[{"id": 4, "name": "cmdb"}, {"id": 12, "name": "owners"}]`)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 0)
+
+	rule, err := c.GetMappingRule(context.Background(), "12")
+	if err != nil {
+		t.Fatalf("GetMappingRule: %s", err)
+	}
+	if rule.Name != "owners" {
+		t.Errorf("expected rule owners, got %v", rule.Name)
+	}
+
+	if _, err := c.GetMappingRule(context.Background(), "7"); !IsNotFound(err) {
+		t.Errorf("expected a not found error, got: %v", err)
+	}
+}
+
+func TestListIncidentAlertFingerprintsPagination(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/incidents/inc-1/alerts" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		const total = 130
+		var items []string
+		for i := offset; i < offset+limit && i < total; i++ {
+			items = append(items, fmt.Sprintf(`{"fingerprint": "fp-%d"}`, i))
+		}
+		fmt.Fprintf(w, `{"items": [%s], "limit": %d, "offset": %d, "count": %d}`, strings.Join(items, ","), limit, offset, total)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 0)
+
+	fingerprints, err := c.ListIncidentAlertFingerprints(context.Background(), "inc-1")
+	if err != nil {
+		t.Fatalf("ListIncidentAlertFingerprints: %s", err)
+	}
+	if len(fingerprints) != 130 || fingerprints[0] != "fp-0" || fingerprints[129] != "fp-129" {
+		t.Errorf("unexpected fingerprints: %d, first %q", len(fingerprints), fingerprints[0])
+	}
+}
+
+func TestUpdateMappingRuleInPlace(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/mapping/12" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("decoding request: %s", err)
+		}
+		if body["id"] != float64(12) {
+			t.Errorf("expected numeric id 12 in the body, got %v", body["id"])
+		}
+		fmt.Fprintf(w, `{"id": 12, "name": %q}`, body["name"])
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 0)
+
+	rule, err := c.UpdateMappingRule(context.Background(), "12", MappingRule{Name: "owners"})
+	if err != nil {
+		t.Fatalf("UpdateMappingRule: %s", err)
+	}
+	if rule.ID != "12" || rule.Name != "owners" {
+		t.Errorf("unexpected rule: %+v", rule)
+	}
+}
+
+func TestUpdateMappingRuleReplaceFallback(t *testing.T) {
+	tests := map[string]struct {
+		deleteStatus int
+		wantStale    bool
+	}{
+		"previous rule deleted":     {deleteStatus: http.StatusOK},
+		"previous rule gone":        {deleteStatus: http.StatusNotFound},
+		"previous rule not deleted": {deleteStatus: http.StatusForbidden, wantStale: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls = append(calls, r.Method+" "+r.URL.Path)
+				switch r.Method {
+				case http.MethodPut:
+					w.WriteHeader(http.StatusMethodNotAllowed)
+				case http.MethodPost:
+					var body map[string]interface{}
+					if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+						t.Errorf("decoding request: %s", err)
+					}
+					if _, ok := body["id"]; ok {
+						t.Errorf("expected no id in the create body, got %v", body["id"])
+					}
+					fmt.Fprint(w, `{"id": 13, "name": "owners"}`)
+				case http.MethodDelete:
+					w.WriteHeader(tt.deleteStatus)
+					fmt.Fprint(w, `{}`)
+				}
+			}))
+			defer server.Close()
+
+			c := newTestClient(t, server.URL, 0)
+
+			rule, err := c.UpdateMappingRule(context.Background(), "12", MappingRule{Name: "owners"})
+			var staleErr *StaleMappingRuleError
+			if gotStale := errors.As(err, &staleErr); gotStale != tt.wantStale {
+				t.Fatalf("expected stale error %t, got: %v", tt.wantStale, err)
+			}
+			if !tt.wantStale && err != nil {
+				t.Fatalf("UpdateMappingRule: %s", err)
+			}
+			if rule == nil || rule.ID != "13" {
+				t.Errorf("expected the replacement rule, got %+v", rule)
+			}
+
+			// The replacement is created before the previous rule is deleted
+			want := []string{"PUT /mapping/12", "POST /mapping", "DELETE /mapping/12"}
+			if strings.Join(calls, ", ") != strings.Join(want, ", ") {
+				t.Errorf("expected calls %v, got %v", want, calls)
+			}
+		})
+	}
+}
+
+func TestUpdateMappingRuleCreateFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPut:
+			w.WriteHeader(http.StatusNotFound)
+		case http.MethodPost:
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"detail": "invalid rows"}`)
+		default:
+			t.Errorf("the previous rule must be kept when the replacement fails, got %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 0)
+
+	if _, err := c.UpdateMappingRule(context.Background(), "12", MappingRule{Name: "owners"}); err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestGetExtractionRuleDirect(t *testing.T) {
+	var lists int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/extraction/7":
+			fmt.Fprint(w, `{"id": 7, "name": "hostname"}`)
+		case "/extraction":
+			atomic.AddInt32(&lists, 1)
+			fmt.Fprint(w, `[{"id": 7, "name": "hostname"}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"detail": "Extraction rule not found"}`)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 0)
+
+	rule, err := c.GetExtractionRule(context.Background(), "7")
+	if err != nil {
+		t.Fatalf("GetExtractionRule: %s", err)
+	}
+	if rule.Name != "hostname" {
+		t.Errorf("got rule %+v, want hostname", rule)
+	}
+	if lists != 0 {
+		t.Errorf("listed extraction rules %d times, want 0", lists)
+	}
+
+	// A missing rule is confirmed against the list
+	if _, err := c.GetExtractionRule(context.Background(), "8"); !IsNotFound(err) {
+		t.Fatalf("expected a not found error, got: %v", err)
+	}
+}
+
+func TestGetExtractionRuleListCache(t *testing.T) {
+	var lists, gets int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/extraction" && r.Method == http.MethodGet:
+			atomic.AddInt32(&lists, 1)
+			fmt.Fprint(w, `[{"id": 1, "name": "a"}, {"id": 2, "name": "b"}, {"id": 3, "name": "c"}]`)
+		case r.URL.Path == "/extraction" && r.Method == http.MethodPost:
+			fmt.Fprint(w, `{"id": 4, "name": "d"}`)
+		case r.Method == http.MethodGet:
+			// Servers without GET /extraction/{id} only route PUT and DELETE
+			atomic.AddInt32(&gets, 1)
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 0)
+
+	// The first lookup finds out that direct lookups are unsupported
+	if _, err := c.GetExtractionRule(context.Background(), "1"); err != nil {
+		t.Fatalf("GetExtractionRule: %s", err)
+	}
+
+	// Concurrent lookups share the cached list
+	var wg sync.WaitGroup
+	errs := make(chan error, 30)
+	for i := 0; i < 30; i++ {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			rule, err := c.GetExtractionRule(context.Background(), id)
+			if err == nil && string(rule.ID) != id {
+				err = fmt.Errorf("got rule %+v for ID %s", rule, id)
+			}
+			errs <- err
+		}(strconv.Itoa(i%3 + 1))
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	if lists != 1 || gets != 1 {
+		t.Errorf("got %d list and %d get requests, want 1 each", lists, gets)
+	}
+
+	// Writes invalidate the cache
+	if _, err := c.CreateExtractionRule(context.Background(), ExtractionRule{Name: "d"}); err != nil {
+		t.Fatalf("CreateExtractionRule: %s", err)
+	}
+	if _, err := c.GetExtractionRule(context.Background(), "2"); err != nil {
+		t.Fatalf("GetExtractionRule: %s", err)
+	}
+	if lists != 2 {
+		t.Errorf("got %d list requests after a write, want 2", lists)
+	}
+}
+
+func TestListCacheSharesRequest(t *testing.T) {
+	var cache listCache[ExtractionRule]
+	var calls int32
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) ([]ExtractionRule, error) {
+		atomic.AddInt32(&calls, 1)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		<-release
+		return []ExtractionRule{{ID: "1"}}, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cache.get(context.Background(), fetch); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+
+	// Wait for the first caller to start the request before releasing it
+	for atomic.LoadInt32(&calls) == 0 {
+		runtime.Gosched()
+	}
+	close(release)
+	wg.Wait()
+
+	if calls != 1 {
+		t.Errorf("fetched %d times, want 1", calls)
+	}
+
+	// Failed requests are not cached
+	cache.invalidate()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := cache.get(ctx, fetch); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got: %v", err)
+	}
+	if _, err := cache.get(context.Background(), fetch); err != nil {
+		t.Errorf("get after a canceled caller: %s", err)
+	}
+	if calls != 3 {
+		t.Errorf("fetched %d times, want 3", calls)
+	}
+}
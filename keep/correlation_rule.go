@@ -0,0 +1,108 @@
+// correlation_rule.go - Correlation rule API client methods
+package keep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+// CreateCorrelationRule creates a new correlation rule
+func (c *Client) CreateCorrelationRule(ctx context.Context, req CorrelationRuleRequest) (*CorrelationRule, error) {
+	defer c.correlationRules.invalidate()
+
+	resp, err := c.Post(ctx, "/rules", withSQLQuery(req))
+	if err != nil {
+		return nil, fmt.Errorf("error creating correlation rule: %w", err)
+	}
+
+	var rule CorrelationRule
+	if err := json.Unmarshal(resp, &rule); err != nil {
+		return nil, fmt.Errorf("error parsing correlation rule response: %w", err)
+	}
+
+	return &rule, nil
+}
+
+// GetCorrelationRule retrieves a correlation rule by ID
+func (c *Client) GetCorrelationRule(ctx context.Context, id string) (*CorrelationRule, error) {
+	// The rules API has no endpoint for a single rule, so look the rule up
+	// in the shared list instead
+	rules, err := c.correlationRules.get(ctx, c.ListCorrelationRules)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range rules {
+		if rule.ID == id {
+			// rule is a copy, as the cached list is shared
+			return &rule, nil
+		}
+	}
+
+	return nil, newNotFoundError(http.MethodGet, "/rules", fmt.Sprintf("correlation rule with ID %s not found", id))
+}
+
+// UpdateCorrelationRule updates an existing correlation rule
+func (c *Client) UpdateCorrelationRule(ctx context.Context, id string, req CorrelationRuleRequest) (*CorrelationRule, error) {
+	defer c.correlationRules.invalidate()
+
+	urlPath := path.Join("/rules", url.PathEscape(id))
+	resp, err := c.Put(ctx, urlPath, withSQLQuery(req))
+	if err != nil {
+		return nil, fmt.Errorf("error updating correlation rule: %w", err)
+	}
+
+	var rule CorrelationRule
+	if err := json.Unmarshal(resp, &rule); err != nil {
+		return nil, fmt.Errorf("error parsing correlation rule response: %w", err)
+	}
+
+	return &rule, nil
+}
+
+// DeleteCorrelationRule deletes a correlation rule by ID
+func (c *Client) DeleteCorrelationRule(ctx context.Context, id string) error {
+	defer c.correlationRules.invalidate()
+
+	urlPath := path.Join("/rules", url.PathEscape(id))
+	_, err := c.Delete(ctx, urlPath)
+	if err != nil {
+		return fmt.Errorf("error deleting correlation rule: %w", err)
+	}
+
+	return nil
+}
+
+// ListCorrelationRules retrieves all correlation rules
+func (c *Client) ListCorrelationRules(ctx context.Context) ([]CorrelationRule, error) {
+	resp, err := c.Get(ctx, "/rules")
+	if err != nil {
+		return nil, fmt.Errorf("error listing correlation rules: %w", err)
+	}
+
+	var rules []CorrelationRule
+	if err := json.Unmarshal(resp, &rules); err != nil {
+		return nil, fmt.Errorf("error parsing correlation rules list: %w", err)
+	}
+
+	return rules, nil
+}
+
+// withSQLQuery fills in the SQL form of the rule definition, which the API
+// requires but does not evaluate; rules are matched using the CEL query.
+func withSQLQuery(req CorrelationRuleRequest) CorrelationRuleRequest {
+	if req.SQLQuery == nil {
+		req.SQLQuery = map[string]interface{}{
+			"sql":    "",
+			"params": map[string]interface{}{},
+		}
+	}
+	if req.GroupingCriteria == nil {
+		req.GroupingCriteria = []string{}
+	}
+	return req
+}
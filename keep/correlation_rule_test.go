@@ -0,0 +1,119 @@
+// correlation_rule_test.go - Unit tests for the correlation rule API client methods
+package keep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestCorrelationRuleRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/rules":
+			var body map[string]interface{}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("decoding request: %s", err)
+			}
+			// The API requires the SQL form and a grouping list even when unused
+			if _, ok := body["sqlQuery"].(map[string]interface{}); !ok {
+				t.Errorf("expected a sqlQuery object, got %v", body["sqlQuery"])
+			}
+			if _, ok := body["groupingCriteria"].([]interface{}); !ok {
+				t.Errorf("expected a groupingCriteria list, got %v", body["groupingCriteria"])
+			}
+			fmt.Fprintf(w, `{"id": "rule-1", "name": %q, "definition_cel": %q, "timeframe": 600}`, body["ruleName"], body["celQuery"])
+		case r.Method == http.MethodGet && r.URL.Path == "/rules":
+			fmt.Fprint(w, `[{"id": "rule-1", "name": "db", "definition_cel": "source == 'prometheus'", "grouping_criteria": ["labels.service"]}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 0)
+	ctx := context.Background()
+
+	created, err := c.CreateCorrelationRule(ctx, CorrelationRuleRequest{
+		RuleName:           "db",
+		CELQuery:           "source == 'prometheus'",
+		TimeframeInSeconds: 600,
+	})
+	if err != nil {
+		t.Fatalf("CreateCorrelationRule: %s", err)
+	}
+	if created.ID != "rule-1" || created.Timeframe != 600 {
+		t.Errorf("unexpected rule: %+v", created)
+	}
+
+	rule, err := c.GetCorrelationRule(ctx, "rule-1")
+	if err != nil {
+		t.Fatalf("GetCorrelationRule: %s", err)
+	}
+	if len(rule.GroupingCriteria) != 1 || rule.GroupingCriteria[0] != "labels.service" {
+		t.Errorf("unexpected grouping criteria: %v", rule.GroupingCriteria)
+	}
+
+	if _, err := c.GetCorrelationRule(ctx, "rule-2"); !IsNotFound(err) {
+		t.Errorf("expected a not found error, got: %v", err)
+	}
+}
+
+func TestGetCorrelationRuleListCache(t *testing.T) {
+	var lists int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/rules":
+			atomic.AddInt32(&lists, 1)
+			fmt.Fprint(w, `[{"id": "rule-1"}, {"id": "rule-2"}, {"id": "rule-3"}]`)
+		case r.Method == http.MethodDelete:
+			fmt.Fprint(w, `{}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 0)
+
+	// Rules refreshed in parallel share one list request
+	var wg sync.WaitGroup
+	errs := make(chan error, 30)
+	for i := 0; i < 30; i++ {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			rule, err := c.GetCorrelationRule(context.Background(), id)
+			if err == nil && rule.ID != id {
+				err = fmt.Errorf("got rule %+v for ID %s", rule, id)
+			}
+			errs <- err
+		}(fmt.Sprintf("rule-%d", i%3+1))
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	if lists != 1 {
+		t.Errorf("got %d list requests, want 1", lists)
+	}
+
+	// Writes invalidate the cache
+	if err := c.DeleteCorrelationRule(context.Background(), "rule-3"); err != nil {
+		t.Fatalf("DeleteCorrelationRule: %s", err)
+	}
+	if _, err := c.GetCorrelationRule(context.Background(), "rule-1"); err != nil {
+		t.Fatalf("GetCorrelationRule: %s", err)
+	}
+	if lists != 2 {
+		t.Errorf("got %d list requests after a write, want 2", lists)
+	}
+}
@@ -0,0 +1,16 @@
+// Package keep is a Go client for the KeepHQ API.
+//
+// Create a Client with New and configure it with options:
+//
+//	client, err := keep.New("https://keep.example.com",
+//		keep.WithAPIKey(apiKey),
+//		keep.WithRetry(keep.RetryPolicy{MaxRetries: 5, WaitMin: time.Second, WaitMax: 30 * time.Second}),
+//		keep.WithLogger(logger),
+//	)
+//
+// Every method takes a context, which bounds the request including its
+// retries. Errors returned by Keep are *APIError values, inspected with
+// IsNotFound and the related helpers.
+//
+// The Terraform provider for Keep is built on this package.
+package keep
@@ -0,0 +1,157 @@
+// errors.go - Typed errors returned by the KeepHQ API client
+package keep
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// requestIDHeaders are the response headers Keep (or the ingress in front of it)
+// may use to identify a request, in order of preference.
+var requestIDHeaders = []string{"X-Request-ID", "X-Correlation-ID", "X-Amzn-Trace-Id"}
+
+// APIError is returned for any non-2xx response from the KeepHQ API
+type APIError struct {
+	// StatusCode is the HTTP status code of the response
+	StatusCode int
+	// Method is the HTTP method of the failed request
+	Method string
+	// Path is the API path of the failed request, relative to the base URL
+	Path string
+	// RequestID is the request identifier returned by the server, if any
+	RequestID string
+	// Detail is the "detail" field of Keep's error payload, or the raw body
+	// when the response is not a Keep error document
+	Detail string
+	// Body is the raw response body
+	Body string
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("API request %s %s failed with status %d", e.Method, e.Path, e.StatusCode)
+	if e.Detail != "" {
+		msg += ": " + e.Detail
+	}
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" (request ID: %s)", e.RequestID)
+	}
+	return msg
+}
+
+// newAPIError builds an APIError from a failed HTTP response and its body
+func newAPIError(method, path string, resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     method,
+		Path:       path,
+		Body:       string(body),
+		Detail:     parseErrorDetail(body),
+	}
+
+	for _, h := range requestIDHeaders {
+		if v := resp.Header.Get(h); v != "" {
+			apiErr.RequestID = v
+			break
+		}
+	}
+
+	return apiErr
+}
+
+// newNotFoundError builds an APIError for objects that could not be located
+// client-side, e.g. when scanning a list response for a matching ID.
+func newNotFoundError(method, path, detail string) *APIError {
+	return &APIError{
+		StatusCode: http.StatusNotFound,
+		Method:     method,
+		Path:       path,
+		Detail:     detail,
+	}
+}
+
+// parseErrorDetail extracts the "detail" field from a Keep (FastAPI) error body.
+// Validation errors carry a list of objects in "detail", which is returned as
+// compact JSON. Bodies that are not JSON are returned trimmed as-is.
+func parseErrorDetail(body []byte) string {
+	var payload struct {
+		Detail json.RawMessage `json:"detail"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil || len(payload.Detail) == 0 {
+		return strings.TrimSpace(string(body))
+	}
+
+	var detail string
+	if err := json.Unmarshal(payload.Detail, &detail); err == nil {
+		return detail
+	}
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, payload.Detail); err != nil {
+		return string(payload.Detail)
+	}
+	return compact.String()
+}
+
+// StatusCode returns the HTTP status code carried by err, or 0 if err does not
+// wrap an APIError.
+func StatusCode(err error) int {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+// IsNotFound reports whether err is a 404 Not Found response.
+func IsNotFound(err error) bool {
+	return StatusCode(err) == http.StatusNotFound
+}
+
+// IsUnauthorized reports whether err is a 401 Unauthorized response.
+func IsUnauthorized(err error) bool {
+	return StatusCode(err) == http.StatusUnauthorized
+}
+
+// IsForbidden reports whether err is a 403 Forbidden response.
+func IsForbidden(err error) bool {
+	return StatusCode(err) == http.StatusForbidden
+}
+
+// IsConflict reports whether err is a 409 Conflict response.
+func IsConflict(err error) bool {
+	return StatusCode(err) == http.StatusConflict
+}
+
+// IsUnprocessable reports whether err is a 422 Unprocessable Entity response,
+// which Keep returns for request validation failures.
+func IsUnprocessable(err error) bool {
+	return StatusCode(err) == http.StatusUnprocessableEntity
+}
+
+// IsServiceUnavailable reports whether err is a 503 Service Unavailable response.
+func IsServiceUnavailable(err error) bool {
+	return StatusCode(err) == http.StatusServiceUnavailable
+}
+
+// StaleMappingRuleError reports that a mapping rule was updated by creating a
+// replacement rule, but the previous rule could not be deleted. The update
+// itself succeeded; the previous rule is left behind.
+type StaleMappingRuleError struct {
+	// ID is the ID of the previous rule
+	ID string
+	// Err is the error deleting the previous rule
+	Err error
+}
+
+func (e *StaleMappingRuleError) Error() string {
+	return fmt.Sprintf("mapping rule %s was replaced, but deleting it failed: %s", e.ID, e.Err)
+}
+
+func (e *StaleMappingRuleError) Unwrap() error {
+	return e.Err
+}
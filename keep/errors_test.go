@@ -0,0 +1,117 @@
+// errors_test.go - Unit tests for typed API errors
+package keep
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDoRequestReturnsAPIError(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		wantDetail string
+		check      func(error) bool
+	}{
+		{
+			name:       "not found with string detail",
+			status:     http.StatusNotFound,
+			body:       `{"detail": "Provider not found"}`,
+			wantDetail: "Provider not found",
+			check:      IsNotFound,
+		},
+		{
+			name:       "unauthorized",
+			status:     http.StatusUnauthorized,
+			body:       `{"detail": "Invalid API Key"}`,
+			wantDetail: "Invalid API Key",
+			check:      IsUnauthorized,
+		},
+		{
+			name:       "conflict",
+			status:     http.StatusConflict,
+			body:       `{"detail": "Rule already exists"}`,
+			wantDetail: "Rule already exists",
+			check:      IsConflict,
+		},
+		{
+			name:       "validation error with list detail",
+			status:     http.StatusUnprocessableEntity,
+			body:       `{"detail": [{"loc": ["body", "name"], "msg": "field required"}]}`,
+			wantDetail: `[{"loc":["body","name"],"msg":"field required"}]`,
+			check:      IsUnprocessable,
+		},
+		{
+			name:       "non-JSON body",
+			status:     http.StatusServiceUnavailable,
+			body:       "<html>upstream unavailable</html>\n",
+			wantDetail: "<html>upstream unavailable</html>",
+			check:      IsServiceUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-ID", "req-123")
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer server.Close()
+
+			c := newTestClient(t, server.URL, 0)
+
+			_, err := c.GetProvider(context.Background(), "abc")
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			if !tt.check(err) {
+				t.Errorf("status check failed for error: %s", err)
+			}
+
+			if StatusCode(err) != tt.status {
+				t.Errorf("StatusCode() = %d, want %d", StatusCode(err), tt.status)
+			}
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("error does not wrap *APIError: %s", err)
+			}
+			if apiErr.Detail != tt.wantDetail {
+				t.Errorf("Detail = %q, want %q", apiErr.Detail, tt.wantDetail)
+			}
+			if apiErr.Method != http.MethodGet || apiErr.Path != "/providers/abc" {
+				t.Errorf("unexpected request in error: %s %s", apiErr.Method, apiErr.Path)
+			}
+			if apiErr.RequestID != "req-123" {
+				t.Errorf("RequestID = %q, want %q", apiErr.RequestID, "req-123")
+			}
+			if !strings.Contains(err.Error(), "req-123") {
+				t.Errorf("error message does not mention the request ID: %s", err)
+			}
+		})
+	}
+}
+
+func TestGetExtractionRuleNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id": 1, "name": "other"}]`)
+	}))
+	defer server.Close()
+
+	c, err := NewClient(server.URL, "test-key")
+	if err != nil {
+		t.Fatalf("NewClient: %s", err)
+	}
+
+	_, err = c.GetExtractionRule(context.Background(), "2")
+	if !IsNotFound(err) {
+		t.Fatalf("expected a not found error, got: %v", err)
+	}
+}
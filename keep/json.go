@@ -0,0 +1,138 @@
+// json.go - JSON types for loosely typed fields of the KeepHQ API
+package keep
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// ID is the ID of an object in Keep. Depending on the endpoint and the Keep
+// version, IDs are returned as numbers or as strings.
+type ID string
+
+// UnmarshalJSON accepts both a JSON number and a JSON string.
+func (id *ID) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*id = ""
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*id = ID(s)
+		return nil
+	}
+
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("ID must be a number or a string, got %s", data)
+	}
+	*id = ID(n.String())
+	return nil
+}
+
+// MarshalJSON sends numeric IDs as numbers, as the request bodies expect.
+// IDs that are not written the way a number is, such as "007", stay strings.
+func (id ID) MarshalJSON() ([]byte, error) {
+	if n, err := strconv.ParseInt(string(id), 10, 64); err == nil && strconv.FormatInt(n, 10) == string(id) {
+		return []byte(id), nil
+	}
+	return json.Marshal(string(id))
+}
+
+// StringMap is a map of strings decoded from a JSON object whose values may be
+// of any type, such as alert labels or mapping rule rows. Numbers and booleans
+// are converted to their JSON text, null to an empty string, and nested
+// objects and arrays are kept as JSON.
+type StringMap map[string]string
+
+// UnmarshalJSON decodes a JSON object, converting its values to strings.
+func (m *StringMap) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*m = nil
+		return nil
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	result := make(StringMap, len(raw))
+	for k, v := range raw {
+		result[k] = jsonString(v)
+	}
+	*m = result
+	return nil
+}
+
+// MappingRuleMatchers maps the alert attributes matched by a mapping rule to
+// their values. Keep stores matchers as a list of [key, value] pairs; older
+// responses return an object instead, and both are accepted.
+type MappingRuleMatchers map[string]string
+
+// UnmarshalJSON decodes a list of [key, value] pairs or an object. Pairs
+// without a string key are skipped, and any other shape decodes to nil.
+func (m *MappingRuleMatchers) UnmarshalJSON(data []byte) error {
+	var pairs []json.RawMessage
+	if err := json.Unmarshal(data, &pairs); err == nil && pairs != nil {
+		result := make(MappingRuleMatchers, len(pairs))
+		for _, item := range pairs {
+			var pair []json.RawMessage
+			if err := json.Unmarshal(item, &pair); err != nil || len(pair) != 2 {
+				continue
+			}
+			var key string
+			if err := json.Unmarshal(pair[0], &key); err != nil {
+				continue
+			}
+			result[key] = jsonString(pair[1])
+		}
+		*m = result
+		return nil
+	}
+
+	var object StringMap
+	if err := json.Unmarshal(data, &object); err == nil && object != nil {
+		*m = MappingRuleMatchers(object)
+		return nil
+	}
+
+	*m = nil
+	return nil
+}
+
+// MarshalJSON encodes the matchers as [key, value] pairs sorted by key.
+func (m MappingRuleMatchers) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([][]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, []string{k, m[k]})
+	}
+	return json.Marshal(pairs)
+}
+
+// jsonString converts a JSON value to a string. Strings are unquoted, null is
+// empty and any other value is returned as JSON text.
+func jsonString(data json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return s
+	}
+	if bytes.Equal(data, []byte("null")) {
+		return ""
+	}
+	return string(bytes.TrimSpace(data))
+}
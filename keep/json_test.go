@@ -0,0 +1,103 @@
+// json_test.go - Unit tests for the loosely typed JSON fields
+package keep
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestIDMarshalJSON(t *testing.T) {
+	tests := map[string]string{
+		"42":                             `42`,
+		"-3":                             `-3`,
+		"0":                              `0`,
+		"007":                            `"007"`,
+		"+5":                             `"+5"`,
+		"-0":                             `"-0"`,
+		"rule-1":                         `"rule-1"`,
+		"":                               `""`,
+		"1e3":                            `"1e3"`,
+		"123456789012345678901234567890": `"123456789012345678901234567890"`,
+	}
+
+	for id, want := range tests {
+		body, err := json.Marshal(ID(id))
+		if err != nil {
+			t.Fatalf("marshal %q: %s", id, err)
+		}
+		if string(body) != want {
+			t.Errorf("expected %q to marshal to %s, got %s", id, want, body)
+		}
+		if !json.Valid(body) {
+			t.Errorf("expected valid JSON for %q, got %s", id, body)
+		}
+	}
+}
+
+func TestStringMapJSON(t *testing.T) {
+	var row StringMap
+	if err := json.Unmarshal([]byte(`{"service": "api", "port": 8080, "ratio": 0.5, "critical": true, "owner": null, "tags": ["a"]}`), &row); err != nil {
+		t.Fatalf("unmarshal: %s", err)
+	}
+	want := StringMap{"service": "api", "port": "8080", "ratio": "0.5", "critical": "true", "owner": "", "tags": `["a"]`}
+	if !reflect.DeepEqual(row, want) {
+		t.Errorf("expected %v, got %v", want, row)
+	}
+
+	if err := json.Unmarshal([]byte(`null`), &row); err != nil || row != nil {
+		t.Errorf("expected null to decode to nil, got %v (%v)", row, err)
+	}
+}
+
+func TestMappingRuleMatchersJSON(t *testing.T) {
+	tests := map[string]struct {
+		data string
+		want MappingRuleMatchers
+	}{
+		"pairs":        {data: `[["service", "api"], ["port", 8080], ["bad"], [1, "x"]]`, want: MappingRuleMatchers{"service": "api", "port": "8080"}},
+		"object":       {data: `{"service": "api", "port": 8080}`, want: MappingRuleMatchers{"service": "api", "port": "8080"}},
+		"empty list":   {data: `[]`, want: MappingRuleMatchers{}},
+		"null":         {data: `null`, want: nil},
+		"other shapes": {data: `"service"`, want: nil},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var rule MappingRule
+			if err := json.Unmarshal([]byte(`{"id": 3, "matchers": `+tt.data+`}`), &rule); err != nil {
+				t.Fatalf("unmarshal: %s", err)
+			}
+			if !reflect.DeepEqual(rule.Matchers, tt.want) {
+				t.Errorf("expected %#v, got %#v", tt.want, rule.Matchers)
+			}
+		})
+	}
+
+	body, err := json.Marshal(MappingRuleMatchers{"service": "api", "env": "prod"})
+	if err != nil {
+		t.Fatalf("marshal: %s", err)
+	}
+	if want := `[["env","prod"],["service","api"]]`; string(body) != want {
+		t.Errorf("expected %s, got %s", want, body)
+	}
+}
+
+func TestExtractionRuleNullableFields(t *testing.T) {
+	var rule ExtractionRule
+	if err := json.Unmarshal([]byte(`{"id": 7, "name": "hostname", "description": null, "condition": null, "priority": null, "regex": "(?P<host>.*)"}`), &rule); err != nil {
+		t.Fatalf("unmarshal: %s", err)
+	}
+	if rule.ID != "7" || rule.Description != nil || rule.Condition != nil || rule.Attribute != nil || rule.Priority != 0 {
+		t.Errorf("unexpected rule: %+v", rule)
+	}
+
+	description := ""
+	body, err := json.Marshal(ExtractionRule{Name: "hostname", Description: &description, Regex: ".*"})
+	if err != nil {
+		t.Fatalf("marshal: %s", err)
+	}
+	if want := `{"name":"hostname","description":"","priority":0,"disabled":false,"pre":false,"regex":".*"}`; string(body) != want {
+		t.Errorf("expected %s, got %s", want, body)
+	}
+}
@@ -0,0 +1,67 @@
+// limit.go - Concurrency and rate limiting of API requests
+package keep
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"golang.org/x/time/rate"
+)
+
+// requestLimiter bounds the requests a Client sends, across all resources and
+// data sources sharing it. The zero value does not limit anything.
+type requestLimiter struct {
+	// slots holds a token per request in flight, nil when unlimited
+	slots chan struct{}
+	// rate is a token bucket refilled requestsPerSecond times a second, nil
+	// when unlimited
+	rate *rate.Limiter
+}
+
+// newRequestLimiter returns a limiter allowing maxConcurrent requests in flight
+// and requestsPerSecond requests a second. Zero disables either limit.
+func newRequestLimiter(maxConcurrent int, requestsPerSecond float64) (requestLimiter, error) {
+	var l requestLimiter
+	if maxConcurrent < 0 {
+		return l, fmt.Errorf("max concurrent requests must not be negative, got %d", maxConcurrent)
+	}
+	if requestsPerSecond < 0 || math.IsNaN(requestsPerSecond) || math.IsInf(requestsPerSecond, 0) {
+		return l, fmt.Errorf("requests per second must be a positive number, got %v", requestsPerSecond)
+	}
+
+	if maxConcurrent > 0 {
+		l.slots = make(chan struct{}, maxConcurrent)
+	}
+	if requestsPerSecond > 0 {
+		// A burst of one spaces requests evenly instead of letting a parallel
+		// apply send a second's worth at once
+		l.rate = rate.NewLimiter(rate.Limit(requestsPerSecond), 1)
+	}
+	return l, nil
+}
+
+// acquire waits for a request slot and a rate token. The returned function
+// releases the slot and must be called once the request completes.
+func (l requestLimiter) acquire(ctx context.Context) (func(), error) {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	release := func() {
+		if l.slots != nil {
+			<-l.slots
+		}
+	}
+
+	if l.rate != nil {
+		if err := l.rate.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+	return release, nil
+}
@@ -0,0 +1,114 @@
+// limit_test.go - Tests for request concurrency and rate limiting
+package keep
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMaxConcurrentRequests(t *testing.T) {
+	var inFlight, maxInFlight int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			m := atomic.LoadInt32(&maxInFlight)
+			if n <= m || atomic.CompareAndSwapInt32(&maxInFlight, m, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClientFromConfig(Config{
+		BaseURL:               server.URL,
+		APIKey:                "test-key",
+		MaxConcurrentRequests: 2,
+	})
+	if err != nil {
+		t.Fatalf("NewClientFromConfig: %s", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Get(context.Background(), "/providers"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&maxInFlight); got != 2 {
+		t.Errorf("got at most %d requests in flight, want 2", got)
+	}
+}
+
+func TestRequestsPerSecond(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	c, err := NewClientFromConfig(Config{
+		BaseURL:           server.URL,
+		APIKey:            "test-key",
+		RequestsPerSecond: 50,
+	})
+	if err != nil {
+		t.Fatalf("NewClientFromConfig: %s", err)
+	}
+
+	// The first request is sent at once, the others 20ms apart
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Get(context.Background(), "/providers"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("6 requests took %s, want about 100ms at 50 requests per second", elapsed)
+	}
+	if got := atomic.LoadInt32(&requests); got != 6 {
+		t.Errorf("server got %d requests, want 6", got)
+	}
+
+	// Waiting for a token honors the context
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	for i := 0; i < 3; i++ {
+		if _, err = c.Get(ctx, "/providers"); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		t.Error("expected an error once the context expired while waiting for the rate limit")
+	}
+}
+
+func TestNewRequestLimiterInvalid(t *testing.T) {
+	if _, err := NewClientFromConfig(Config{MaxConcurrentRequests: -1}); err == nil {
+		t.Error("expected an error for negative MaxConcurrentRequests")
+	}
+	if _, err := NewClientFromConfig(Config{RequestsPerSecond: -1}); err == nil {
+		t.Error("expected an error for negative RequestsPerSecond")
+	}
+}
@@ -0,0 +1,27 @@
+// logger.go - Pluggable logging for the KeepHQ API client
+package keep
+
+import "context"
+
+// Logger receives the client's log messages. Fields carry structured context
+// such as the request method and path; credentials are never included.
+type Logger interface {
+	Debug(ctx context.Context, msg string, fields map[string]interface{})
+	Warn(ctx context.Context, msg string, fields map[string]interface{})
+	Error(ctx context.Context, msg string, fields map[string]interface{})
+}
+
+// nopLogger discards all messages. It is used when no Logger is configured.
+type nopLogger struct{}
+
+func (nopLogger) Debug(context.Context, string, map[string]interface{}) {}
+func (nopLogger) Warn(context.Context, string, map[string]interface{})  {}
+func (nopLogger) Error(context.Context, string, map[string]interface{}) {}
+
+// loggerOrNop returns l, or a Logger discarding all messages when l is nil.
+func loggerOrNop(l Logger) Logger {
+	if l == nil {
+		return nopLogger{}
+	}
+	return l
+}
@@ -0,0 +1,94 @@
+// maintenance.go - Maintenance window API client methods
+package keep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+)
+
+// CreateMaintenanceWindow creates a new maintenance window
+func (c *Client) CreateMaintenanceWindow(ctx context.Context, req MaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	resp, err := c.Post(ctx, "/maintenance", withIgnoreStatuses(req))
+	if err != nil {
+		return nil, fmt.Errorf("error creating maintenance window: %w", err)
+	}
+
+	var window MaintenanceWindow
+	if err := json.Unmarshal(resp, &window); err != nil {
+		return nil, fmt.Errorf("error parsing maintenance window response: %w", err)
+	}
+
+	return &window, nil
+}
+
+// GetMaintenanceWindow retrieves a maintenance window by ID
+func (c *Client) GetMaintenanceWindow(ctx context.Context, id string) (*MaintenanceWindow, error) {
+	// The maintenance API has no endpoint for a single window
+	windows, err := c.ListMaintenanceWindows(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range windows {
+		if strconv.FormatInt(windows[i].ID, 10) == id {
+			return &windows[i], nil
+		}
+	}
+
+	return nil, newNotFoundError(http.MethodGet, "/maintenance", fmt.Sprintf("maintenance window with ID %s not found", id))
+}
+
+// UpdateMaintenanceWindow updates an existing maintenance window
+func (c *Client) UpdateMaintenanceWindow(ctx context.Context, id string, req MaintenanceWindowRequest) (*MaintenanceWindow, error) {
+	urlPath := path.Join("/maintenance", url.PathEscape(id))
+	resp, err := c.Put(ctx, urlPath, withIgnoreStatuses(req))
+	if err != nil {
+		return nil, fmt.Errorf("error updating maintenance window: %w", err)
+	}
+
+	var window MaintenanceWindow
+	if err := json.Unmarshal(resp, &window); err != nil {
+		return nil, fmt.Errorf("error parsing maintenance window response: %w", err)
+	}
+
+	return &window, nil
+}
+
+// DeleteMaintenanceWindow deletes a maintenance window by ID
+func (c *Client) DeleteMaintenanceWindow(ctx context.Context, id string) error {
+	urlPath := path.Join("/maintenance", url.PathEscape(id))
+	_, err := c.Delete(ctx, urlPath)
+	if err != nil {
+		return fmt.Errorf("error deleting maintenance window: %w", err)
+	}
+
+	return nil
+}
+
+// ListMaintenanceWindows retrieves all maintenance windows
+func (c *Client) ListMaintenanceWindows(ctx context.Context) ([]MaintenanceWindow, error) {
+	resp, err := c.Get(ctx, "/maintenance")
+	if err != nil {
+		return nil, fmt.Errorf("error listing maintenance windows: %w", err)
+	}
+
+	var windows []MaintenanceWindow
+	if err := json.Unmarshal(resp, &windows); err != nil {
+		return nil, fmt.Errorf("error parsing maintenance windows list: %w", err)
+	}
+
+	return windows, nil
+}
+
+// withIgnoreStatuses sends an empty list rather than null, which the API rejects
+func withIgnoreStatuses(req MaintenanceWindowRequest) MaintenanceWindowRequest {
+	if req.IgnoreStatuses == nil {
+		req.IgnoreStatuses = []string{}
+	}
+	return req
+}
@@ -0,0 +1,268 @@
+// models.go - Data models for the KeepHQ API
+package keep
+
+// Provider represents a KeepHQ provider
+type Provider struct {
+	ID               string            `json:"id,omitempty"`
+	Name             string            `json:"name"`
+	Type             string            `json:"type"`
+	Config           map[string]string `json:"config,omitempty"`
+	Installed        bool              `json:"installed,omitempty"`
+	LastAlertReceived string            `json:"last_alert_received,omitempty"`
+}
+
+// CreateProviderRequest represents the request body for creating a provider
+type CreateProviderRequest struct {
+	Name    string            `json:"name"`
+	Type    string            `json:"type"`
+	Config  map[string]string `json:"config"`
+	Webhook *bool             `json:"webhook,omitempty"`
+}
+
+// UpdateProviderRequest represents the request body for updating a provider
+type UpdateProviderRequest struct {
+	Name   string            `json:"name,omitempty"`
+	Config map[string]string `json:"config,omitempty"`
+}
+
+// ProviderResponse represents the API response for provider operations
+type ProviderResponse struct {
+	Provider Provider `json:"provider"`
+}
+
+// ListProvidersResponse represents the API response for listing providers.
+// Providers is the catalogue of provider types that can be installed, while
+// InstalledProviders holds the installed instances and their IDs.
+type ListProvidersResponse struct {
+	Providers          []Provider `json:"providers"`
+	InstalledProviders []Provider `json:"installed_providers"`
+}
+
+// Workflow represents a KeepHQ workflow as returned by the workflows API
+type Workflow struct {
+	ID           string            `json:"id"`
+	Name         string            `json:"name"`
+	Description  string            `json:"description,omitempty"`
+	CreatedBy    string            `json:"created_by,omitempty"`
+	CreationTime string            `json:"creation_time,omitempty"`
+	Triggers     []WorkflowTrigger `json:"triggers,omitempty"`
+	Disabled     bool              `json:"disabled"`
+	Provisioned  bool              `json:"provisioned,omitempty"`
+	WorkflowRaw  string            `json:"workflow_raw"`
+	Revision     int               `json:"revision"`
+	LastUpdated  string            `json:"last_updated,omitempty"`
+}
+
+// WorkflowTrigger represents a single trigger of a workflow, such as manual, interval or alert
+type WorkflowTrigger struct {
+	Type string `json:"type"`
+}
+
+// WorkflowResponse represents the API response for creating or updating a workflow
+type WorkflowResponse struct {
+	WorkflowID string `json:"workflow_id"`
+	Status     string `json:"status"`
+	Revision   int    `json:"revision,omitempty"`
+}
+
+// CorrelationRule represents an alert-to-incident correlation rule as returned by the rules API
+type CorrelationRule struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	DefinitionCEL    string   `json:"definition_cel"`
+	Timeframe        int64    `json:"timeframe"`
+	TimeUnit         string   `json:"timeunit"`
+	GroupingCriteria []string `json:"grouping_criteria"`
+	RequireApprove   bool     `json:"require_approve"`
+	ResolveOn        string   `json:"resolve_on"`
+	CreateOn         string   `json:"create_on"`
+	CreatedBy        string   `json:"created_by,omitempty"`
+	CreationTime     string   `json:"creation_time,omitempty"`
+	UpdatedBy        string   `json:"updated_by,omitempty"`
+	UpdateTime       string   `json:"update_time,omitempty"`
+}
+
+// CorrelationRuleRequest represents the request body for creating or updating a correlation rule
+type CorrelationRuleRequest struct {
+	RuleName           string                 `json:"ruleName"`
+	SQLQuery           map[string]interface{} `json:"sqlQuery"`
+	CELQuery           string                 `json:"celQuery"`
+	TimeframeInSeconds int64                  `json:"timeframeInSeconds"`
+	TimeUnit           string                 `json:"timeUnit"`
+	GroupingCriteria   []string               `json:"groupingCriteria"`
+	RequireApprove     bool                   `json:"requireApprove"`
+	ResolveOn          string                 `json:"resolveOn"`
+	CreateOn           string                 `json:"createOn"`
+}
+
+// DeduplicationRule represents a provider deduplication rule as returned by the deduplications API
+type DeduplicationRule struct {
+	ID                string   `json:"id"`
+	Name              string   `json:"name"`
+	Description       string   `json:"description"`
+	Default           bool     `json:"default"`
+	ProviderID        string   `json:"provider_id"`
+	ProviderType      string   `json:"provider_type"`
+	FingerprintFields []string `json:"fingerprint_fields"`
+	FullDeduplication bool     `json:"full_deduplication"`
+	IgnoreFields      []string `json:"ignore_fields"`
+	CreatedBy         string   `json:"created_by,omitempty"`
+	CreatedAt         string   `json:"created_at,omitempty"`
+	LastUpdated       string   `json:"last_updated,omitempty"`
+}
+
+// DeduplicationRuleRequest represents the request body for creating or updating a deduplication rule
+type DeduplicationRuleRequest struct {
+	Name              string   `json:"name"`
+	Description       string   `json:"description"`
+	ProviderID        *string  `json:"provider_id"`
+	ProviderType      string   `json:"provider_type"`
+	FingerprintFields []string `json:"fingerprint_fields"`
+	FullDeduplication bool     `json:"full_deduplication"`
+	IgnoreFields      []string `json:"ignore_fields"`
+}
+
+// MaintenanceWindow represents a maintenance window as returned by the maintenance API
+type MaintenanceWindow struct {
+	ID              int64    `json:"id"`
+	Name            string   `json:"name"`
+	Description     string   `json:"description"`
+	CreatedBy       string   `json:"created_by,omitempty"`
+	CELQuery        string   `json:"cel_query"`
+	StartTime       string   `json:"start_time"`
+	EndTime         string   `json:"end_time"`
+	DurationSeconds int64    `json:"duration_seconds"`
+	UpdatedAt       string   `json:"updated_at,omitempty"`
+	Suppress        bool     `json:"suppress"`
+	Enabled         bool     `json:"enabled"`
+	IgnoreStatuses  []string `json:"ignore_statuses"`
+}
+
+// MaintenanceWindowRequest represents the request body for creating or updating a maintenance window
+type MaintenanceWindowRequest struct {
+	Name            string   `json:"name"`
+	Description     string   `json:"description,omitempty"`
+	CELQuery        string   `json:"cel_query"`
+	StartTime       string   `json:"start_time"`
+	DurationSeconds int64    `json:"duration_seconds"`
+	Suppress        bool     `json:"suppress"`
+	Enabled         bool     `json:"enabled"`
+	IgnoreStatuses  []string `json:"ignore_statuses"`
+}
+
+// PresetOption is a labelled preset option, such as the CEL or SQL form of the preset query
+type PresetOption struct {
+	Label string      `json:"label"`
+	Value interface{} `json:"value"`
+}
+
+// PresetTag is a tag attached to a preset
+type PresetTag struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name"`
+}
+
+// Preset represents a saved alert view as returned by the preset API
+type Preset struct {
+	ID                     string         `json:"id"`
+	Name                   string         `json:"name"`
+	Options                []PresetOption `json:"options"`
+	CreatedBy              string         `json:"created_by,omitempty"`
+	IsPrivate              bool           `json:"is_private"`
+	IsNoisy                bool           `json:"is_noisy"`
+	ShouldDoNoiseNow       bool           `json:"should_do_noise_now"`
+	Static                 bool           `json:"static"`
+	CounterShowsFiringOnly bool           `json:"counter_shows_firing_only"`
+	Tags                   []PresetTag    `json:"tags"`
+}
+
+// PresetRequest represents the request body for creating or updating a preset
+type PresetRequest struct {
+	Name                   string         `json:"name"`
+	Options                []PresetOption `json:"options"`
+	IsPrivate              bool           `json:"is_private"`
+	IsNoisy                bool           `json:"is_noisy"`
+	CounterShowsFiringOnly bool           `json:"counter_shows_firing_only"`
+	Tags                   []PresetTag    `json:"tags"`
+}
+
+// Option returns the value of the preset option with the given label, or nil
+func (p *Preset) Option(label string) interface{} {
+	for _, option := range p.Options {
+		if option.Label == label {
+			return option.Value
+		}
+	}
+	return nil
+}
+
+// TopologyService represents a service in the topology as returned by the topology API
+type TopologyService struct {
+	ID               TopologyID                  `json:"id"`
+	SourceProviderID string                      `json:"source_provider_id,omitempty"`
+	Service          string                      `json:"service"`
+	DisplayName      string                      `json:"display_name"`
+	Environment      string                      `json:"environment,omitempty"`
+	Description      string                      `json:"description,omitempty"`
+	Team             string                      `json:"team,omitempty"`
+	Email            string                      `json:"email,omitempty"`
+	Slack            string                      `json:"slack,omitempty"`
+	Repository       string                      `json:"repository,omitempty"`
+	Tags             []string                    `json:"tags"`
+	IsManual         bool                        `json:"is_manual,omitempty"`
+	Dependencies     []TopologyServiceDependency `json:"dependencies,omitempty"`
+	ApplicationIDs   []string                    `json:"application_ids,omitempty"`
+}
+
+// TopologyServiceDependency is a dependency listed on a topology service
+type TopologyServiceDependency struct {
+	ID          TopologyID `json:"id"`
+	ServiceID   TopologyID `json:"serviceId"`
+	ServiceName string     `json:"serviceName"`
+	Protocol    string     `json:"protocol"`
+}
+
+// TopologyServiceRequest represents the request body for creating or updating a topology service
+type TopologyServiceRequest struct {
+	ID          TopologyID `json:"id,omitempty"`
+	Service     string     `json:"service"`
+	DisplayName string     `json:"display_name"`
+	Environment string     `json:"environment,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Team        string     `json:"team,omitempty"`
+	Email       string     `json:"email,omitempty"`
+	Slack       string     `json:"slack,omitempty"`
+	Repository  string     `json:"repository,omitempty"`
+	Tags        []string   `json:"tags"`
+}
+
+// TopologyDependency represents a service-to-service dependency as returned by the topology API
+type TopologyDependency struct {
+	ID                 TopologyID `json:"id"`
+	ServiceID          TopologyID `json:"service_id"`
+	DependsOnServiceID TopologyID `json:"depends_on_service_id"`
+	Protocol           string     `json:"protocol"`
+}
+
+// TopologyDependencyRequest represents the request body for creating or updating a dependency
+type TopologyDependencyRequest struct {
+	ID                 TopologyID `json:"id,omitempty"`
+	ServiceID          TopologyID `json:"service_id"`
+	DependsOnServiceID TopologyID `json:"depends_on_service_id"`
+	Protocol           string     `json:"protocol"`
+}
+
+// TopologyApplication represents an application grouping topology services
+type TopologyApplication struct {
+	ID          string                       `json:"id,omitempty"`
+	Name        string                       `json:"name"`
+	Description string                       `json:"description"`
+	Repository  string                       `json:"repository,omitempty"`
+	Services    []TopologyApplicationService `json:"services"`
+}
+
+// TopologyApplicationService is a service that is part of a topology application
+type TopologyApplicationService struct {
+	ID   TopologyID `json:"id"`
+	Name string     `json:"name,omitempty"`
+}
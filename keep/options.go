@@ -0,0 +1,88 @@
+// options.go - Functional options for creating a KeepHQ API client
+package keep
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option configures a Client created with New
+type Option func(*Config)
+
+// New creates a new KeepHQ API client for baseURL, configured by opts.
+// An empty baseURL defaults to DefaultBaseURL.
+func New(baseURL string, opts ...Option) (*Client, error) {
+	cfg := Config{BaseURL: baseURL}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return NewClientFromConfig(cfg)
+}
+
+// WithAPIKey authenticates requests with Keep's X-API-KEY header
+func WithAPIKey(apiKey string) Option {
+	return func(cfg *Config) {
+		cfg.APIKey = apiKey
+	}
+}
+
+// WithAuth authenticates requests with auth, e.g. a BearerTokenAuth or an
+// OAuth2ClientCredentialsAuth. It takes precedence over WithAPIKey.
+func WithAuth(auth Authenticator) Option {
+	return func(cfg *Config) {
+		cfg.Auth = auth
+	}
+}
+
+// WithRetry sets the policy for retrying failed requests
+func WithRetry(policy RetryPolicy) Option {
+	return func(cfg *Config) {
+		cfg.RetryPolicy = &policy
+	}
+}
+
+// WithHTTPClient sends requests through httpClient. It cannot be combined with
+// WithTLS, WithProxyURL or WithTimeout, which configure the HTTP client built
+// by default.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(cfg *Config) {
+		cfg.HTTPClient = httpClient
+	}
+}
+
+// WithTLS customizes certificate verification and client certificates
+func WithTLS(tls TLSConfig) Option {
+	return func(cfg *Config) {
+		cfg.TLS = &tls
+	}
+}
+
+// WithProxyURL routes requests through the given proxy
+func WithProxyURL(proxyURL string) Option {
+	return func(cfg *Config) {
+		cfg.ProxyURL = proxyURL
+	}
+}
+
+// WithTimeout bounds each request attempt
+func WithTimeout(timeout time.Duration) Option {
+	return func(cfg *Config) {
+		cfg.Timeout = timeout
+	}
+}
+
+// WithRateLimit bounds the requests in flight at once and the requests sent
+// per second. Zero disables either limit.
+func WithRateLimit(maxConcurrent int, requestsPerSecond float64) Option {
+	return func(cfg *Config) {
+		cfg.MaxConcurrentRequests = maxConcurrent
+		cfg.RequestsPerSecond = requestsPerSecond
+	}
+}
+
+// WithLogger sends the client's log messages to logger
+func WithLogger(logger Logger) Option {
+	return func(cfg *Config) {
+		cfg.Logger = logger
+	}
+}
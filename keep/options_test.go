@@ -0,0 +1,116 @@
+// options_test.go - Unit tests for the client options and logger
+package keep
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// recordingLogger records the messages it receives
+type recordingLogger struct {
+	mu       sync.Mutex
+	messages []string
+	fields   []map[string]interface{}
+}
+
+func (l *recordingLogger) record(level, msg string, fields map[string]interface{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.messages = append(l.messages, level+": "+msg)
+	l.fields = append(l.fields, fields)
+}
+
+func (l *recordingLogger) Debug(_ context.Context, msg string, fields map[string]interface{}) {
+	l.record("debug", msg, fields)
+}
+
+func (l *recordingLogger) Warn(_ context.Context, msg string, fields map[string]interface{}) {
+	l.record("warn", msg, fields)
+}
+
+func (l *recordingLogger) Error(_ context.Context, msg string, fields map[string]interface{}) {
+	l.record("error", msg, fields)
+}
+
+func (l *recordingLogger) has(message string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, m := range l.messages {
+		if m == message {
+			return true
+		}
+	}
+	return false
+}
+
+func TestNewWithOptions(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer test-token" {
+			t.Errorf("Authorization = %q, want a bearer token", got)
+		}
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"providers": []}`)
+	}))
+	defer server.Close()
+
+	var transportCalls int32
+	httpClient := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&transportCalls, 1)
+		return http.DefaultTransport.RoundTrip(req)
+	})}
+	logger := &recordingLogger{}
+
+	c, err := New(server.URL,
+		WithAPIKey("ignored"),
+		WithAuth(&BearerTokenAuth{Token: "test-token"}),
+		WithHTTPClient(httpClient),
+		WithRetry(RetryPolicy{MaxRetries: 1, WaitMin: time.Millisecond, WaitMax: time.Millisecond}),
+		WithLogger(logger),
+	)
+	if err != nil {
+		t.Fatalf("New: %s", err)
+	}
+
+	if _, err := c.ListProviders(context.Background()); err != nil {
+		t.Fatalf("ListProviders: %s", err)
+	}
+	if attempts != 2 || transportCalls != 2 {
+		t.Errorf("got %d attempts through %d custom transport calls, want 2 each", attempts, transportCalls)
+	}
+	if !logger.has("warn: Retrying KeepHQ API request") {
+		t.Errorf("expected the retry to be logged, got %v", logger.messages)
+	}
+}
+
+func TestNewHTTPClientConflicts(t *testing.T) {
+	tests := map[string]Option{
+		"tls":     WithTLS(TLSConfig{InsecureSkipVerify: true}),
+		"proxy":   WithProxyURL("http://proxy.example.com:3128"),
+		"timeout": WithTimeout(time.Second),
+	}
+
+	for name, opt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := New("", WithHTTPClient(&http.Client{}), opt); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
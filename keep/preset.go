@@ -0,0 +1,110 @@
+// preset.go - Preset API client methods
+package keep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+// CreatePreset creates a new preset
+func (c *Client) CreatePreset(ctx context.Context, req PresetRequest) (*Preset, error) {
+	resp, err := c.Post(ctx, "/preset", withTags(req))
+	if err != nil {
+		return nil, fmt.Errorf("error creating preset: %w", err)
+	}
+
+	var preset Preset
+	if err := json.Unmarshal(resp, &preset); err != nil {
+		return nil, fmt.Errorf("error parsing preset response: %w", err)
+	}
+
+	return &preset, nil
+}
+
+// GetPreset retrieves a preset by ID
+func (c *Client) GetPreset(ctx context.Context, id string) (*Preset, error) {
+	// The preset API has no endpoint for a single preset
+	presets, err := c.ListPresets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range presets {
+		if presets[i].ID == id {
+			return &presets[i], nil
+		}
+	}
+
+	return nil, newNotFoundError(http.MethodGet, "/preset", fmt.Sprintf("preset with ID %s not found", id))
+}
+
+// GetPresetByName retrieves a preset by name. Preset names are unique per tenant.
+func (c *Client) GetPresetByName(ctx context.Context, name string) (*Preset, error) {
+	presets, err := c.ListPresets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range presets {
+		if presets[i].Name == name {
+			return &presets[i], nil
+		}
+	}
+
+	return nil, newNotFoundError(http.MethodGet, "/preset", fmt.Sprintf("preset named %q not found", name))
+}
+
+// UpdatePreset updates an existing preset
+func (c *Client) UpdatePreset(ctx context.Context, id string, req PresetRequest) (*Preset, error) {
+	urlPath := path.Join("/preset", url.PathEscape(id))
+	resp, err := c.Put(ctx, urlPath, withTags(req))
+	if err != nil {
+		return nil, fmt.Errorf("error updating preset: %w", err)
+	}
+
+	var preset Preset
+	if err := json.Unmarshal(resp, &preset); err != nil {
+		return nil, fmt.Errorf("error parsing preset response: %w", err)
+	}
+
+	return &preset, nil
+}
+
+// DeletePreset deletes a preset by ID
+func (c *Client) DeletePreset(ctx context.Context, id string) error {
+	urlPath := path.Join("/preset", url.PathEscape(id))
+	_, err := c.Delete(ctx, urlPath)
+	if err != nil {
+		return fmt.Errorf("error deleting preset: %w", err)
+	}
+
+	return nil
+}
+
+// ListPresets retrieves all presets visible to the caller, including the
+// static presets Keep provides
+func (c *Client) ListPresets(ctx context.Context) ([]Preset, error) {
+	resp, err := c.Get(ctx, "/preset")
+	if err != nil {
+		return nil, fmt.Errorf("error listing presets: %w", err)
+	}
+
+	var presets []Preset
+	if err := json.Unmarshal(resp, &presets); err != nil {
+		return nil, fmt.Errorf("error parsing presets list: %w", err)
+	}
+
+	return presets, nil
+}
+
+// withTags sends an empty tag list instead of null, which the API rejects
+func withTags(req PresetRequest) PresetRequest {
+	if req.Tags == nil {
+		req.Tags = []PresetTag{}
+	}
+	return req
+}
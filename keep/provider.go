@@ -0,0 +1,225 @@
+// provider.go - Provider-related API client methods
+package keep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+// CreateProvider creates a new provider in KeepHQ by installing it
+func (c *Client) CreateProvider(ctx context.Context, req CreateProviderRequest) (*Provider, error) {
+	// The provider installation requires a specific format
+	installReq := map[string]interface{}{
+		"provider_id":   req.Type, // Use type as provider_id
+		"provider_name": req.Name,
+		"provider_type": req.Type,
+		"pulling_enabled": true, // Default to true unless specified
+	}
+
+	// Add the config as top-level fields in the request
+	// Since req.Config is map[string]string, we can directly assign values
+	for k, v := range req.Config {
+		installReq[k] = v
+	}
+
+	// The config holds the provider credentials, so only its size is logged
+	c.logger.Debug(ctx, "Creating provider", map[string]interface{}{
+		"provider_name": req.Name,
+		"provider_type": req.Type,
+		"config_keys":   len(req.Config),
+	})
+
+	// Use the install endpoint for provider creation
+	// Pass the raw map to Post, which will handle the JSON marshaling
+	resp, err := c.Post(ctx, "/providers/install", installReq)
+	if err != nil {
+		c.logger.Error(ctx, "Failed to install provider", map[string]interface{}{
+			"provider_name": req.Name,
+			"provider_type": req.Type,
+			"error":         err.Error(),
+		})
+		return nil, fmt.Errorf("error installing provider: %w", err)
+	}
+
+	// The response might be just the provider object, not wrapped in a ProviderResponse
+	var provider Provider
+	if err := json.Unmarshal(resp, &provider); err != nil {
+		c.logger.Error(ctx, "Failed to parse provider response", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, fmt.Errorf("error parsing provider response: %w", err)
+	}
+
+	c.logger.Debug(ctx, "Created provider", map[string]interface{}{
+		"provider_id":   provider.ID,
+		"provider_type": provider.Type,
+	})
+	return &provider, nil
+}
+
+// GetProvider retrieves a provider by ID
+func (c *Client) GetProvider(ctx context.Context, id string) (*Provider, error) {
+	urlPath := path.Join("/providers", url.PathEscape(id))
+	resp, err := c.Get(ctx, urlPath)
+	if err != nil {
+		return nil, fmt.Errorf("error getting provider: %w", err)
+	}
+
+	var providerResp ProviderResponse
+	if err := json.Unmarshal(resp, &providerResp); err != nil {
+		return nil, fmt.Errorf("error parsing provider response: %w", err)
+	}
+
+	return &providerResp.Provider, nil
+}
+
+// UpdateProvider updates an existing provider
+func (c *Client) UpdateProvider(ctx context.Context, id string, req UpdateProviderRequest) (*Provider, error) {
+	urlPath := path.Join("/providers", url.PathEscape(id))
+	resp, err := c.Put(ctx, urlPath, req)
+	if err != nil {
+		return nil, fmt.Errorf("error updating provider: %w", err)
+	}
+
+	var providerResp ProviderResponse
+	if err := json.Unmarshal(resp, &providerResp); err != nil {
+		return nil, fmt.Errorf("error parsing provider response: %w", err)
+	}
+
+	return &providerResp.Provider, nil
+}
+
+// DeleteProvider deletes a provider by ID
+func (c *Client) DeleteProvider(ctx context.Context, id string) error {
+	urlPath := path.Join("/providers", url.PathEscape(id))
+	_, err := c.Delete(ctx, urlPath)
+	if err != nil {
+		return fmt.Errorf("error deleting provider: %w", err)
+	}
+
+	return nil
+}
+
+// ListProviders retrieves the installed providers followed by the provider
+// types that can be installed, with Installed telling them apart
+func (c *Client) ListProviders(ctx context.Context) ([]Provider, error) {
+	listResp, err := c.listProviders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	providers := make([]Provider, 0, len(listResp.InstalledProviders)+len(listResp.Providers))
+	providers = append(providers, listResp.InstalledProviders...)
+	for _, p := range listResp.Providers {
+		p.Installed = false
+		providers = append(providers, p)
+	}
+	return providers, nil
+}
+
+// ListInstalledProviders retrieves the installed providers, which are the
+// providers with an ID that other objects such as deduplication rules refer to
+func (c *Client) ListInstalledProviders(ctx context.Context) ([]Provider, error) {
+	listResp, err := c.listProviders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return listResp.InstalledProviders, nil
+}
+
+// listProviders fetches GET /providers, marking the installed providers as such
+func (c *Client) listProviders(ctx context.Context) (*ListProvidersResponse, error) {
+	resp, err := c.Get(ctx, "/providers")
+	if err != nil {
+		return nil, fmt.Errorf("error listing providers: %w", err)
+	}
+
+	var listResp ListProvidersResponse
+	if err := json.Unmarshal(resp, &listResp); err != nil {
+		return nil, fmt.Errorf("error parsing providers list: %w", err)
+	}
+
+	for i := range listResp.InstalledProviders {
+		listResp.InstalledProviders[i].Installed = true
+	}
+	return &listResp, nil
+}
+
+// CreateDeduplicationRule creates a new deduplication rule for a provider
+func (c *Client) CreateDeduplicationRule(ctx context.Context, req DeduplicationRuleRequest) (*DeduplicationRule, error) {
+	resp, err := c.Post(ctx, "/deduplications", req)
+	if err != nil {
+		return nil, fmt.Errorf("error creating deduplication rule: %w", err)
+	}
+
+	var rule DeduplicationRule
+	if err := json.Unmarshal(resp, &rule); err != nil {
+		return nil, fmt.Errorf("error parsing deduplication rule response: %w", err)
+	}
+
+	return &rule, nil
+}
+
+// GetDeduplicationRule retrieves a deduplication rule by ID
+func (c *Client) GetDeduplicationRule(ctx context.Context, id string) (*DeduplicationRule, error) {
+	// The deduplications API has no endpoint for a single rule
+	rules, err := c.ListDeduplicationRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rules {
+		if rules[i].ID == id {
+			return &rules[i], nil
+		}
+	}
+
+	return nil, newNotFoundError(http.MethodGet, "/deduplications", fmt.Sprintf("deduplication rule with ID %s not found", id))
+}
+
+// UpdateDeduplicationRule updates an existing deduplication rule
+func (c *Client) UpdateDeduplicationRule(ctx context.Context, id string, req DeduplicationRuleRequest) (*DeduplicationRule, error) {
+	urlPath := path.Join("/deduplications", url.PathEscape(id))
+	resp, err := c.Put(ctx, urlPath, req)
+	if err != nil {
+		return nil, fmt.Errorf("error updating deduplication rule: %w", err)
+	}
+
+	var rule DeduplicationRule
+	if err := json.Unmarshal(resp, &rule); err != nil {
+		return nil, fmt.Errorf("error parsing deduplication rule response: %w", err)
+	}
+
+	return &rule, nil
+}
+
+// DeleteDeduplicationRule deletes a deduplication rule by ID
+func (c *Client) DeleteDeduplicationRule(ctx context.Context, id string) error {
+	urlPath := path.Join("/deduplications", url.PathEscape(id))
+	_, err := c.Delete(ctx, urlPath)
+	if err != nil {
+		return fmt.Errorf("error deleting deduplication rule: %w", err)
+	}
+
+	return nil
+}
+
+// ListDeduplicationRules retrieves all deduplication rules, including the
+// default rules Keep creates for each provider
+func (c *Client) ListDeduplicationRules(ctx context.Context) ([]DeduplicationRule, error) {
+	resp, err := c.Get(ctx, "/deduplications")
+	if err != nil {
+		return nil, fmt.Errorf("error listing deduplication rules: %w", err)
+	}
+
+	var rules []DeduplicationRule
+	if err := json.Unmarshal(resp, &rules); err != nil {
+		return nil, fmt.Errorf("error parsing deduplication rules list: %w", err)
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,118 @@
+// provider_test.go - Unit tests for the provider endpoints
+package keep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestUpdateProviderRequestBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/providers/prov-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body UpdateProviderRequest
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("expected a JSON object body: %s", err)
+		}
+		if body.Name != "grafana" || body.Config["api_token"] != "secret" {
+			t.Errorf("unexpected body: %+v", body)
+		}
+		fmt.Fprint(w, `{"provider": {"id": "prov-1", "name": "grafana", "type": "grafana"}}`)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 0)
+
+	provider, err := c.UpdateProvider(context.Background(), "prov-1", UpdateProviderRequest{
+		Name:   "grafana",
+		Config: map[string]string{"api_token": "secret"},
+	})
+	if err != nil {
+		t.Fatalf("UpdateProvider: %s", err)
+	}
+	if provider.ID != "prov-1" {
+		t.Errorf("unexpected provider: %+v", provider)
+	}
+}
+
+func TestCreateProviderDoesNotLogConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "prov-1", "name": "grafana", "type": "grafana"}`)
+	}))
+	defer server.Close()
+
+	logger := &recordingLogger{}
+	c, err := New(server.URL, WithAPIKey("test-key"), WithLogger(logger))
+	if err != nil {
+		t.Fatalf("New: %s", err)
+	}
+
+	if _, err := c.CreateProvider(context.Background(), CreateProviderRequest{
+		Name:   "grafana",
+		Type:   "grafana",
+		Config: map[string]string{"api_token": "super-secret"},
+	}); err != nil {
+		t.Fatalf("CreateProvider: %s", err)
+	}
+
+	if !logger.has("debug: Creating provider") {
+		t.Errorf("expected the installation to be logged, got %v", logger.messages)
+	}
+	for _, fields := range logger.fields {
+		if strings.Contains(fmt.Sprint(fields), "super-secret") {
+			t.Errorf("provider credentials were logged: %v", fields)
+		}
+	}
+}
+
+func TestListInstalledProviders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"providers": [{"id": null, "type": "prometheus", "installed": false}],
+			"installed_providers": [{"id": "prom-1", "type": "prometheus"}]
+		}`)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 0)
+
+	providers, err := c.ListInstalledProviders(context.Background())
+	if err != nil {
+		t.Fatalf("ListInstalledProviders: %s", err)
+	}
+	if len(providers) != 1 || providers[0].ID != "prom-1" || !providers[0].Installed {
+		t.Errorf("unexpected providers: %+v", providers)
+	}
+}
+
+func TestListProvidersMergesInstalled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"providers": [{"id": null, "type": "prometheus", "installed": true}, {"id": null, "type": "datadog"}],
+			"installed_providers": [{"id": "prom-1", "type": "prometheus"}]
+		}`)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 0)
+
+	providers, err := c.ListProviders(context.Background())
+	if err != nil {
+		t.Fatalf("ListProviders: %s", err)
+	}
+	want := []Provider{
+		{ID: "prom-1", Type: "prometheus", Installed: true},
+		{Type: "prometheus"},
+		{Type: "datadog"},
+	}
+	if !reflect.DeepEqual(providers, want) {
+		t.Errorf("expected %+v, got %+v", want, providers)
+	}
+}
@@ -0,0 +1,152 @@
+// retry.go - Retry policy and backoff for the KeepHQ API client
+package keep
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the default number of retries after the first attempt
+	DefaultMaxRetries = 3
+	// DefaultRetryWaitMin is the default minimum wait between retries
+	DefaultRetryWaitMin = 1 * time.Second
+	// DefaultRetryWaitMax is the default maximum wait between retries
+	DefaultRetryWaitMax = 30 * time.Second
+)
+
+// RetryPolicy controls how the client retries failed requests
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt. Zero disables retries.
+	MaxRetries int
+	// WaitMin is the base backoff used for the first retry
+	WaitMin time.Duration
+	// WaitMax caps the exponential backoff
+	WaitMax time.Duration
+}
+
+// DefaultRetryPolicy returns the retry policy used when none is configured
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries: DefaultMaxRetries,
+		WaitMin:    DefaultRetryWaitMin,
+		WaitMax:    DefaultRetryWaitMax,
+	}
+}
+
+// idempotentPosts lists POST endpoints that do not change server state and
+// can therefore be replayed like a GET.
+var idempotentPosts = map[string]bool{
+	"/alerts/search": true,
+}
+
+// isIdempotent reports whether a request can be safely replayed after a
+// failure that may have reached the server.
+func isIdempotent(method, path string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	case http.MethodPost:
+		return idempotentPosts[path]
+	default:
+		return false
+	}
+}
+
+// shouldRetry decides whether a failed attempt is worth retrying. Requests that
+// are not idempotent (e.g. POST /alerts/event or /providers/install) are only
+// retried when the server provably did not process them: a 429 response or a
+// connection that could not be established.
+func shouldRetry(ctx context.Context, method, path string, statusCode int, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	idempotent := isIdempotent(method, path)
+
+	if err != nil {
+		// Only failures of the round trip itself are transient; errors building
+		// the request would fail identically on every attempt.
+		var urlErr *url.Error
+		if !errors.As(err, &urlErr) {
+			return false
+		}
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return true
+		}
+		return idempotent
+	}
+
+	switch statusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent
+	default:
+		return false
+	}
+}
+
+// backoff returns the wait before the given retry (0-based). It uses capped
+// exponential backoff with jitter, or the server's Retry-After when present.
+// Retry-After is capped at WaitMax so that a server asking for an hour does
+// not block the caller for that long.
+func (p RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, p.WaitMax)
+		}
+	}
+
+	wait := float64(p.WaitMin) * math.Pow(2, float64(attempt))
+	if wait > float64(p.WaitMax) || wait <= 0 {
+		wait = float64(p.WaitMax)
+	}
+
+	// Equal jitter: keep half of the backoff and randomize the rest so that
+	// parallel resources do not retry in lockstep.
+	half := wait / 2
+	return time.Duration(half + rand.Float64()*half)
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if when, err := http.ParseTime(value); err == nil {
+		wait := time.Until(when)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for d or until ctx is done, whichever comes first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,163 @@
+// retry_test.go - Unit tests for request retries
+package keep
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, url string, maxRetries int) *Client {
+	t.Helper()
+
+	c, err := NewClientFromConfig(Config{
+		BaseURL: url,
+		APIKey:  "test-key",
+		RetryPolicy: &RetryPolicy{
+			MaxRetries: maxRetries,
+			WaitMin:    time.Millisecond,
+			WaitMax:    5 * time.Millisecond,
+		},
+	})
+	if err != nil {
+		t.Fatalf("NewClientFromConfig: %s", err)
+	}
+	return c
+}
+
+func TestRetryTransientErrors(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"providers": []}`)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 3)
+	if _, err := c.ListProviders(context.Background()); err != nil {
+		t.Fatalf("expected success after retries, got: %s", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Errorf("expected 3 calls, got %d", got)
+	}
+}
+
+func TestRetryGivesUpAfterMaxRetries(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 2)
+	_, err := c.ListProviders(context.Background())
+	if StatusCode(err) != http.StatusBadGateway {
+		t.Fatalf("expected a 502 error, got: %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 3 {
+		t.Errorf("expected 3 calls, got %d", got)
+	}
+}
+
+func TestRetrySkipsNonIdempotentPost(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 3)
+	_, err := c.CreateAlert(context.Background(), Alert{Name: "test"})
+	if !IsServiceUnavailable(err) {
+		t.Fatalf("expected a 503 error, got: %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected POST /alerts/event not to be replayed, got %d calls", got)
+	}
+}
+
+func TestRetryHonorsRetryAfterForPost(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 3)
+	if _, err := c.CreateAlert(context.Background(), Alert{Name: "test"}); err != nil {
+		t.Fatalf("expected success after a 429, got: %s", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("expected 2 calls, got %d", got)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := RetryPolicy{WaitMin: 100 * time.Millisecond, WaitMax: time.Second}
+
+	for attempt := 0; attempt < 10; attempt++ {
+		wait := p.backoff(attempt, nil)
+		if wait > p.WaitMax {
+			t.Errorf("attempt %d: backoff %s exceeds maximum %s", attempt, wait, p.WaitMax)
+		}
+		if wait < p.WaitMin/2 {
+			t.Errorf("attempt %d: backoff %s below half the minimum %s", attempt, wait, p.WaitMin)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"7"}}}
+	if wait := (RetryPolicy{WaitMin: time.Second, WaitMax: 10 * time.Second}).backoff(0, resp); wait != 7*time.Second {
+		t.Errorf("expected Retry-After to be honored, got %s", wait)
+	}
+	if wait := p.backoff(0, resp); wait != p.WaitMax {
+		t.Errorf("expected Retry-After to be capped at %s, got %s", p.WaitMax, wait)
+	}
+}
+
+func TestRetryAfterBeyondDeadline(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	c, err := NewClientFromConfig(Config{
+		BaseURL:     server.URL,
+		APIKey:      "test-key",
+		RetryPolicy: &RetryPolicy{MaxRetries: 3, WaitMin: time.Second, WaitMax: 2 * time.Hour},
+	})
+	if err != nil {
+		t.Fatalf("NewClientFromConfig: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	_, err = c.ListProviders(ctx)
+	if StatusCode(err) != http.StatusTooManyRequests {
+		t.Fatalf("expected the 429 to be returned, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("waited %s for a retry past the deadline", elapsed)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected 1 call, got %d", got)
+	}
+}
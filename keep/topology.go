@@ -0,0 +1,249 @@
+// topology.go - Topology API client methods
+package keep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+// TopologyID is the ID of a topology service or dependency. The topology API
+// returns these as numbers from some endpoints and as strings from others.
+type TopologyID = ID
+
+// CreateTopologyService creates a manually defined topology service
+func (c *Client) CreateTopologyService(ctx context.Context, req TopologyServiceRequest) (*TopologyService, error) {
+	req.ID = ""
+	resp, err := c.Post(ctx, "/topology/service", withServiceTags(req))
+	if err != nil {
+		return nil, fmt.Errorf("error creating topology service: %w", err)
+	}
+
+	var service TopologyService
+	if err := json.Unmarshal(resp, &service); err != nil {
+		return nil, fmt.Errorf("error parsing topology service response: %w", err)
+	}
+
+	return &service, nil
+}
+
+// GetTopologyService retrieves a topology service by ID
+func (c *Client) GetTopologyService(ctx context.Context, id string) (*TopologyService, error) {
+	// The topology API has no endpoint for a single service
+	services, err := c.ListTopologyServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range services {
+		if string(services[i].ID) == id {
+			return &services[i], nil
+		}
+	}
+
+	return nil, newNotFoundError(http.MethodGet, "/topology", fmt.Sprintf("topology service with ID %s not found", id))
+}
+
+// UpdateTopologyService updates a manually defined topology service
+func (c *Client) UpdateTopologyService(ctx context.Context, id string, req TopologyServiceRequest) (*TopologyService, error) {
+	req.ID = TopologyID(id)
+	resp, err := c.Put(ctx, "/topology/service", withServiceTags(req))
+	if err != nil {
+		return nil, fmt.Errorf("error updating topology service: %w", err)
+	}
+
+	var service TopologyService
+	if err := json.Unmarshal(resp, &service); err != nil {
+		return nil, fmt.Errorf("error parsing topology service response: %w", err)
+	}
+
+	return &service, nil
+}
+
+// DeleteTopologyService deletes a topology service together with its dependencies
+func (c *Client) DeleteTopologyService(ctx context.Context, id string) error {
+	_, err := c.doRequest(ctx, http.MethodDelete, "/topology/services", []TopologyID{TopologyID(id)})
+	if err != nil {
+		return fmt.Errorf("error deleting topology service: %w", err)
+	}
+
+	return nil
+}
+
+// ListTopologyServices retrieves all topology services, including those
+// pulled from providers
+func (c *Client) ListTopologyServices(ctx context.Context) ([]TopologyService, error) {
+	resp, err := c.Get(ctx, "/topology?include_empty_deps=true")
+	if err != nil {
+		return nil, fmt.Errorf("error listing topology services: %w", err)
+	}
+
+	var services []TopologyService
+	if err := json.Unmarshal(resp, &services); err != nil {
+		return nil, fmt.Errorf("error parsing topology services list: %w", err)
+	}
+
+	return services, nil
+}
+
+// CreateTopologyDependency creates a dependency between two topology services
+func (c *Client) CreateTopologyDependency(ctx context.Context, req TopologyDependencyRequest) (*TopologyDependency, error) {
+	req.ID = ""
+	resp, err := c.Post(ctx, "/topology/dependency", req)
+	if err != nil {
+		return nil, fmt.Errorf("error creating topology dependency: %w", err)
+	}
+
+	var dependency TopologyDependency
+	if err := json.Unmarshal(resp, &dependency); err != nil {
+		return nil, fmt.Errorf("error parsing topology dependency response: %w", err)
+	}
+
+	return &dependency, nil
+}
+
+// GetTopologyDependency retrieves a dependency by ID
+func (c *Client) GetTopologyDependency(ctx context.Context, id string) (*TopologyDependency, error) {
+	// Dependencies are only listed on the services that have them
+	services, err := c.ListTopologyServices(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, service := range services {
+		for _, dependency := range service.Dependencies {
+			if string(dependency.ID) == id {
+				return &TopologyDependency{
+					ID:                 dependency.ID,
+					ServiceID:          service.ID,
+					DependsOnServiceID: dependency.ServiceID,
+					Protocol:           dependency.Protocol,
+				}, nil
+			}
+		}
+	}
+
+	return nil, newNotFoundError(http.MethodGet, "/topology", fmt.Sprintf("topology dependency with ID %s not found", id))
+}
+
+// UpdateTopologyDependency updates an existing dependency
+func (c *Client) UpdateTopologyDependency(ctx context.Context, id string, req TopologyDependencyRequest) (*TopologyDependency, error) {
+	req.ID = TopologyID(id)
+	resp, err := c.Put(ctx, "/topology/dependency", req)
+	if err != nil {
+		return nil, fmt.Errorf("error updating topology dependency: %w", err)
+	}
+
+	var dependency TopologyDependency
+	if err := json.Unmarshal(resp, &dependency); err != nil {
+		return nil, fmt.Errorf("error parsing topology dependency response: %w", err)
+	}
+
+	return &dependency, nil
+}
+
+// DeleteTopologyDependency deletes a dependency by ID
+func (c *Client) DeleteTopologyDependency(ctx context.Context, id string) error {
+	urlPath := path.Join("/topology/dependency", url.PathEscape(id))
+	_, err := c.Delete(ctx, urlPath)
+	if err != nil {
+		return fmt.Errorf("error deleting topology dependency: %w", err)
+	}
+
+	return nil
+}
+
+// CreateTopologyApplication creates an application grouping topology services
+func (c *Client) CreateTopologyApplication(ctx context.Context, app TopologyApplication) (*TopologyApplication, error) {
+	app.ID = ""
+	resp, err := c.Post(ctx, "/topology/applications", withApplicationServices(app))
+	if err != nil {
+		return nil, fmt.Errorf("error creating topology application: %w", err)
+	}
+
+	var created TopologyApplication
+	if err := json.Unmarshal(resp, &created); err != nil {
+		return nil, fmt.Errorf("error parsing topology application response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetTopologyApplication retrieves an application by ID
+func (c *Client) GetTopologyApplication(ctx context.Context, id string) (*TopologyApplication, error) {
+	// The topology API has no endpoint for a single application
+	apps, err := c.ListTopologyApplications(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range apps {
+		if apps[i].ID == id {
+			return &apps[i], nil
+		}
+	}
+
+	return nil, newNotFoundError(http.MethodGet, "/topology/applications", fmt.Sprintf("topology application with ID %s not found", id))
+}
+
+// UpdateTopologyApplication updates an existing application
+func (c *Client) UpdateTopologyApplication(ctx context.Context, id string, app TopologyApplication) (*TopologyApplication, error) {
+	app.ID = id
+	urlPath := path.Join("/topology/applications", url.PathEscape(id))
+	resp, err := c.Put(ctx, urlPath, withApplicationServices(app))
+	if err != nil {
+		return nil, fmt.Errorf("error updating topology application: %w", err)
+	}
+
+	var updated TopologyApplication
+	if err := json.Unmarshal(resp, &updated); err != nil {
+		return nil, fmt.Errorf("error parsing topology application response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteTopologyApplication deletes an application by ID. Its services are kept.
+func (c *Client) DeleteTopologyApplication(ctx context.Context, id string) error {
+	urlPath := path.Join("/topology/applications", url.PathEscape(id))
+	_, err := c.Delete(ctx, urlPath)
+	if err != nil {
+		return fmt.Errorf("error deleting topology application: %w", err)
+	}
+
+	return nil
+}
+
+// ListTopologyApplications retrieves all topology applications
+func (c *Client) ListTopologyApplications(ctx context.Context) ([]TopologyApplication, error) {
+	resp, err := c.Get(ctx, "/topology/applications")
+	if err != nil {
+		return nil, fmt.Errorf("error listing topology applications: %w", err)
+	}
+
+	var apps []TopologyApplication
+	if err := json.Unmarshal(resp, &apps); err != nil {
+		return nil, fmt.Errorf("error parsing topology applications list: %w", err)
+	}
+
+	return apps, nil
+}
+
+// withServiceTags sends an empty tag list instead of null
+func withServiceTags(req TopologyServiceRequest) TopologyServiceRequest {
+	if req.Tags == nil {
+		req.Tags = []string{}
+	}
+	return req
+}
+
+// withApplicationServices sends an empty service list instead of null
+func withApplicationServices(app TopologyApplication) TopologyApplication {
+	if app.Services == nil {
+		app.Services = []TopologyApplicationService{}
+	}
+	return app
+}
@@ -0,0 +1,58 @@
+// topology_test.go - Unit tests for the topology API client methods
+package keep
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTopologyIDJSON(t *testing.T) {
+	var service TopologyService
+	if err := json.Unmarshal([]byte(`{"id": 12, "dependencies": [{"id": "7", "serviceId": 13, "serviceName": "db"}]}`), &service); err != nil {
+		t.Fatalf("unmarshal: %s", err)
+	}
+	if service.ID != "12" || service.Dependencies[0].ID != "7" || service.Dependencies[0].ServiceID != "13" {
+		t.Errorf("unexpected IDs: %+v", service)
+	}
+
+	body, err := json.Marshal(TopologyDependencyRequest{ServiceID: "12", DependsOnServiceID: "13", Protocol: "gRPC"})
+	if err != nil {
+		t.Fatalf("marshal: %s", err)
+	}
+	if want := `{"service_id":12,"depends_on_service_id":13,"protocol":"gRPC"}`; string(body) != want {
+		t.Errorf("expected %s, got %s", want, body)
+	}
+}
+
+func TestGetTopologyDependency(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/topology" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `[
+			{"id": 1, "service": "api", "display_name": "API", "dependencies": [{"id": 5, "serviceId": 2, "serviceName": "db", "protocol": "tcp"}]},
+			{"id": 2, "service": "db", "display_name": "Database", "dependencies": []}
+		]`)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 0)
+	ctx := context.Background()
+
+	dependency, err := c.GetTopologyDependency(ctx, "5")
+	if err != nil {
+		t.Fatalf("GetTopologyDependency: %s", err)
+	}
+	if dependency.ServiceID != "1" || dependency.DependsOnServiceID != "2" || dependency.Protocol != "tcp" {
+		t.Errorf("unexpected dependency: %+v", dependency)
+	}
+
+	if _, err := c.GetTopologyDependency(ctx, "6"); !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
@@ -0,0 +1,73 @@
+// transport.go - HTTP transport construction for the KeepHQ API client
+package keep
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// TLSConfig holds the TLS settings used to reach the KeepHQ API
+type TLSConfig struct {
+	// CACertPEM holds additional PEM encoded CA certificates to trust, e.g. a private CA.
+	// They are added to the system pool.
+	CACertPEM []byte
+	// ClientCertPEM and ClientKeyPEM hold a PEM encoded client certificate and key for mTLS
+	ClientCertPEM []byte
+	ClientKeyPEM  []byte
+	// InsecureSkipVerify disables server certificate verification. Only use for testing.
+	InsecureSkipVerify bool
+}
+
+// newTransport builds the HTTP transport for the client. Without an explicit
+// proxy URL the standard HTTPS_PROXY, HTTP_PROXY and NO_PROXY variables apply.
+func newTransport(tlsCfg *TLSConfig, proxyURL string) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = http.ProxyFromEnvironment
+
+	if proxyURL != "" {
+		u, err := url.Parse(proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proxy URL: %w", err)
+		}
+		switch u.Scheme {
+		case "http", "https", "socks5":
+		default:
+			return nil, fmt.Errorf("invalid proxy URL: unsupported scheme %q", u.Scheme)
+		}
+		transport.Proxy = http.ProxyURL(u)
+	}
+
+	if tlsCfg == nil {
+		return transport, nil
+	}
+
+	tlsClientConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: tlsCfg.InsecureSkipVerify, //nolint:gosec // explicitly requested by the practitioner
+	}
+
+	if len(tlsCfg.CACertPEM) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(tlsCfg.CACertPEM) {
+			return nil, fmt.Errorf("no valid PEM certificates found in CA bundle")
+		}
+		tlsClientConfig.RootCAs = pool
+	}
+
+	if len(tlsCfg.ClientCertPEM) > 0 || len(tlsCfg.ClientKeyPEM) > 0 {
+		cert, err := tls.X509KeyPair(tlsCfg.ClientCertPEM, tlsCfg.ClientKeyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("error loading client certificate: %w", err)
+		}
+		tlsClientConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport.TLSClientConfig = tlsClientConfig
+	return transport, nil
+}
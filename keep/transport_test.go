@@ -0,0 +1,64 @@
+// transport_test.go - Unit tests for TLS and proxy transport settings
+package keep
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCustomCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"providers": []}`)
+	}))
+	defer server.Close()
+
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	tests := []struct {
+		name    string
+		tls     *TLSConfig
+		wantErr bool
+	}{
+		{name: "system roots only", tls: nil, wantErr: true},
+		{name: "custom CA bundle", tls: &TLSConfig{CACertPEM: caPEM}},
+		{name: "insecure skip verify", tls: &TLSConfig{InsecureSkipVerify: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewClientFromConfig(Config{
+				BaseURL:     server.URL,
+				APIKey:      "test-key",
+				RetryPolicy: &RetryPolicy{},
+				TLS:         tt.tls,
+			})
+			if err != nil {
+				t.Fatalf("NewClientFromConfig: %s", err)
+			}
+
+			_, err = c.ListProviders(context.Background())
+			if tt.wantErr && err == nil {
+				t.Fatal("expected a certificate verification error")
+			}
+			if !tt.wantErr && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+		})
+	}
+}
+
+func TestNewTransportErrors(t *testing.T) {
+	if _, err := newTransport(&TLSConfig{CACertPEM: []byte("not a certificate")}, ""); err == nil {
+		t.Error("expected an error for an invalid CA bundle")
+	}
+	if _, err := newTransport(&TLSConfig{ClientCertPEM: []byte("cert")}, ""); err == nil {
+		t.Error("expected an error for an incomplete client certificate")
+	}
+	if _, err := newTransport(nil, "ftp://proxy.example.com"); err == nil {
+		t.Error("expected an error for an unsupported proxy scheme")
+	}
+}
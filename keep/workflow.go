@@ -0,0 +1,121 @@
+// workflow.go - Workflow-related API client methods
+package keep
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+// CreateWorkflow uploads a workflow definition in YAML format. Keep assigns the
+// workflow ID, which is returned together with the initial revision.
+func (c *Client) CreateWorkflow(ctx context.Context, workflowYAML string) (*WorkflowResponse, error) {
+	defer c.workflows.invalidate()
+
+	// The workflows endpoint only accepts new workflows as a file upload
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	part, err := writer.CreateFormFile("file", "workflow.yaml")
+	if err != nil {
+		return nil, fmt.Errorf("error building workflow upload: %w", err)
+	}
+	if _, err := part.Write([]byte(workflowYAML)); err != nil {
+		return nil, fmt.Errorf("error building workflow upload: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("error building workflow upload: %w", err)
+	}
+
+	resp, err := c.doRawRequest(ctx, http.MethodPost, "/workflows", writer.FormDataContentType(), body.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error creating workflow: %w", err)
+	}
+
+	var workflowResp WorkflowResponse
+	if err := json.Unmarshal(resp, &workflowResp); err != nil {
+		return nil, fmt.Errorf("error parsing workflow response: %w", err)
+	}
+	if workflowResp.WorkflowID == "" {
+		return nil, fmt.Errorf("workflow response did not include a workflow ID: %s", string(resp))
+	}
+
+	return &workflowResp, nil
+}
+
+// GetWorkflow retrieves a workflow by ID, including its raw YAML definition
+func (c *Client) GetWorkflow(ctx context.Context, id string) (*Workflow, error) {
+	// The single workflow endpoint returns executions rather than the
+	// definition, so look the workflow up in the shared list instead
+	workflows, err := c.workflows.get(ctx, c.ListWorkflows)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, workflow := range workflows {
+		if workflow.ID == id {
+			// workflow is a copy, as the cached list is shared
+			return &workflow, nil
+		}
+	}
+
+	return nil, newNotFoundError(http.MethodGet, "/workflows", fmt.Sprintf("workflow with ID %s not found", id))
+}
+
+// UpdateWorkflow replaces the definition of an existing workflow, creating a new revision
+func (c *Client) UpdateWorkflow(ctx context.Context, id string, workflowYAML string) (*WorkflowResponse, error) {
+	defer c.workflows.invalidate()
+
+	urlPath := path.Join("/workflows", url.PathEscape(id))
+	resp, err := c.doRawRequest(ctx, http.MethodPut, urlPath, "application/x-yaml", []byte(workflowYAML))
+	if err != nil {
+		return nil, fmt.Errorf("error updating workflow: %w", err)
+	}
+
+	var workflowResp WorkflowResponse
+	if err := json.Unmarshal(resp, &workflowResp); err != nil {
+		return nil, fmt.Errorf("error parsing workflow response: %w", err)
+	}
+
+	return &workflowResp, nil
+}
+
+// DeleteWorkflow deletes a workflow by ID
+func (c *Client) DeleteWorkflow(ctx context.Context, id string) error {
+	defer c.workflows.invalidate()
+
+	urlPath := path.Join("/workflows", url.PathEscape(id))
+	_, err := c.Delete(ctx, urlPath)
+	if err != nil {
+		return fmt.Errorf("error deleting workflow: %w", err)
+	}
+
+	return nil
+}
+
+// ListWorkflows retrieves all workflows
+func (c *Client) ListWorkflows(ctx context.Context) ([]Workflow, error) {
+	resp, err := c.Get(ctx, "/workflows")
+	if err != nil {
+		return nil, fmt.Errorf("error listing workflows: %w", err)
+	}
+
+	var workflows []Workflow
+	if err := json.Unmarshal(resp, &workflows); err == nil {
+		return workflows, nil
+	}
+
+	// Newer Keep versions wrap the list in a paginated response
+	var paged struct {
+		Results []Workflow `json:"results"`
+	}
+	if err := json.Unmarshal(resp, &paged); err != nil {
+		return nil, fmt.Errorf("error parsing workflows list: %w", err)
+	}
+
+	return paged.Results, nil
+}
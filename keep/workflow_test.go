@@ -0,0 +1,136 @@
+// workflow_test.go - Unit tests for the workflow API client methods
+package keep
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+const testWorkflowYAML = `workflow:
+  id: example
+  triggers:
+    - type: manual
+  steps: []
+`
+
+func TestWorkflowLifecycle(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/workflows":
+			file, header, err := r.FormFile("file")
+			if err != nil {
+				t.Errorf("reading uploaded workflow: %s", err)
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			defer file.Close()
+			content, _ := io.ReadAll(file)
+			if header.Filename != "workflow.yaml" || string(content) != testWorkflowYAML {
+				t.Errorf("unexpected upload %s: %q", header.Filename, content)
+			}
+			fmt.Fprint(w, `{"workflow_id": "wf-1", "status": "created", "revision": 1}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/workflows/wf-1":
+			if ct := r.Header.Get("Content-Type"); ct != "application/x-yaml" {
+				t.Errorf("expected YAML content type, got %q", ct)
+			}
+			fmt.Fprint(w, `{"workflow_id": "wf-1", "status": "updated", "revision": 2}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/workflows":
+			fmt.Fprint(w, `{"count": 1, "results": [{"id": "wf-1", "name": "example", "revision": 2, "disabled": true, "triggers": [{"type": "manual"}]}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 0)
+	ctx := context.Background()
+
+	created, err := c.CreateWorkflow(ctx, testWorkflowYAML)
+	if err != nil {
+		t.Fatalf("CreateWorkflow: %s", err)
+	}
+	if created.WorkflowID != "wf-1" || created.Revision != 1 {
+		t.Errorf("unexpected create response: %+v", created)
+	}
+
+	updated, err := c.UpdateWorkflow(ctx, "wf-1", strings.Replace(testWorkflowYAML, "manual", "alert", 1))
+	if err != nil {
+		t.Fatalf("UpdateWorkflow: %s", err)
+	}
+	if updated.Revision != 2 {
+		t.Errorf("expected revision 2, got %d", updated.Revision)
+	}
+
+	workflow, err := c.GetWorkflow(ctx, "wf-1")
+	if err != nil {
+		t.Fatalf("GetWorkflow: %s", err)
+	}
+	if !workflow.Disabled || workflow.Revision != 2 || len(workflow.Triggers) != 1 || workflow.Triggers[0].Type != "manual" {
+		t.Errorf("unexpected workflow: %+v", workflow)
+	}
+
+	if _, err := c.GetWorkflow(ctx, "wf-2"); !IsNotFound(err) {
+		t.Errorf("expected a not found error, got: %v", err)
+	}
+}
+
+func TestGetWorkflowListCache(t *testing.T) {
+	var lists int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/workflows":
+			atomic.AddInt32(&lists, 1)
+			fmt.Fprint(w, `[{"id": "wf-1"}, {"id": "wf-2"}, {"id": "wf-3"}]`)
+		case r.Method == http.MethodPut:
+			fmt.Fprint(w, `{"workflow_id": "wf-1", "revision": 2}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, 0)
+
+	// Workflows refreshed in parallel share one list request
+	var wg sync.WaitGroup
+	errs := make(chan error, 30)
+	for i := 0; i < 30; i++ {
+		wg.Add(1)
+		go func(id string) {
+			defer wg.Done()
+			workflow, err := c.GetWorkflow(context.Background(), id)
+			if err == nil && workflow.ID != id {
+				err = fmt.Errorf("got workflow %+v for ID %s", workflow, id)
+			}
+			errs <- err
+		}(fmt.Sprintf("wf-%d", i%3+1))
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	if lists != 1 {
+		t.Errorf("got %d list requests, want 1", lists)
+	}
+
+	// Writes invalidate the cache
+	if _, err := c.UpdateWorkflow(context.Background(), "wf-1", testWorkflowYAML); err != nil {
+		t.Fatalf("UpdateWorkflow: %s", err)
+	}
+	if _, err := c.GetWorkflow(context.Background(), "wf-1"); err != nil {
+		t.Fatalf("GetWorkflow: %s", err)
+	}
+	if lists != 2 {
+		t.Errorf("got %d list requests after a write, want 2", lists)
+	}
+}